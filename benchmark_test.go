@@ -0,0 +1,91 @@
+package gocast_test
+
+import (
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+var (
+	benchInt     any = 12345
+	benchInt64   any = int64(12345)
+	benchFloat   any = 123.45
+	benchString  any = "12345"
+	benchFString any = "123.45"
+	benchBool    any = "true"
+	benchInts    any = []int{1, 2, 3, 4, 5, 6, 7, 8}
+	benchStrings any = []string{"1", "2", "3", "4", "5", "6", "7", "8"}
+)
+
+func TestZeroAllocs(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"ToSigned(int)", func() { _, _ = gocast.ToSigned[int32](benchInt) }},
+		{"ToSigned(int64)", func() { _, _ = gocast.ToSigned[int](benchInt64) }},
+		{"ToSigned(float64)", func() { _, _ = gocast.ToSigned[int64](benchFloat) }},
+		{"ToSigned(string)", func() { _, _ = gocast.ToSigned[int](benchString) }},
+		{"ToSigned(float string)", func() { _, _ = gocast.ToSigned[int](benchFString) }},
+		{"ToUnsigned(int)", func() { _, _ = gocast.ToUnsigned[uint16](benchInt) }},
+		{"ToUnsigned(string)", func() { _, _ = gocast.ToUnsigned[uint64](benchString) }},
+		{"ToFloat(int)", func() { _, _ = gocast.ToFloat[float32](benchInt) }},
+		{"ToFloat(string)", func() { _, _ = gocast.ToFloat[float64](benchFString) }},
+		{"ToBool(string)", func() { _, _ = gocast.ToBool(benchBool) }},
+	}
+
+	for _, test := range tests {
+		if allocs := testing.AllocsPerRun(100, test.fn); allocs != 0 {
+			t.Errorf("%s allocates %v times, expected 0", test.name, allocs)
+		}
+	}
+}
+
+func BenchmarkToSignedInt(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gocast.ToSigned[int32](benchInt)
+	}
+}
+
+func BenchmarkToSignedString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gocast.ToSigned[int](benchString)
+	}
+}
+
+func BenchmarkToUnsignedString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gocast.ToUnsigned[uint](benchString)
+	}
+}
+
+func BenchmarkToFloatString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gocast.ToFloat[float64](benchFString)
+	}
+}
+
+func BenchmarkToBoolString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gocast.ToBool(benchBool)
+	}
+}
+
+func BenchmarkToSignedSliceInts(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gocast.ToSignedSlice[int64](benchInts)
+	}
+}
+
+func BenchmarkToSignedSliceStrings(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gocast.ToSignedSlice[int](benchStrings)
+	}
+}
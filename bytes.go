@@ -0,0 +1,127 @@
+package gocast
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ByteUnits defines the unit system of formatted byte sizes.
+type ByteUnits int
+
+const (
+	// BytesIEC formats byte sizes using binary units (KiB, MiB, GiB, ...) of 1024.
+	BytesIEC ByteUnits = iota
+	// BytesSI formats byte sizes using decimal units (kB, MB, GB, ...) of 1000.
+	BytesSI
+)
+
+var (
+	iecByteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	siByteUnits  = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+)
+
+// byteMultipliers are the multipliers of byte size units by lowercase unit name.
+var byteMultipliers = func() map[string]*big.Rat {
+	res := map[string]*big.Rat{
+		"": big.NewRat(1, 1), "b": big.NewRat(1, 1), "byte": big.NewRat(1, 1), "bytes": big.NewRat(1, 1),
+	}
+
+	si, iec := big.NewInt(1), big.NewInt(1)
+	for _, prefix := range []string{"k", "m", "g", "t", "p", "e"} {
+		si.Mul(si, big.NewInt(1000))
+		iec.Lsh(iec, 10)
+		res[prefix] = new(big.Rat).SetInt(si)
+		res[prefix+"b"] = new(big.Rat).SetInt(si)
+		res[prefix+"i"] = new(big.Rat).SetInt(iec)
+		res[prefix+"ib"] = new(big.Rat).SetInt(iec)
+	}
+	return res
+}()
+
+// ToBytesSize casts an interface to a byte size in bytes.
+// Strings are parsed with optional SI (kB, MB, ...) or IEC (KiB, MiB, ...) units
+// case-insensitively, e.g. "512MB", "1.5 GiB" or "10k". Numbers are treated as bytes.
+func ToBytesSize(value any) (uint64, error) {
+	return toBytesSize(defaultConverter, value)
+}
+
+// ToBytesSize casts an interface to a byte size in bytes using the converter options.
+func (c *Converter) ToBytesSize(value any) (uint64, error) {
+	return toBytesSize(c, value)
+}
+
+// FormatBytes formats the byte size as a human-readable string with at most
+// precision decimals, e.g. "1.5 GiB" (BytesIEC) or "1.61 GB" (BytesSI).
+func FormatBytes(size uint64, units ByteUnits, precision int) string {
+	names, base := iecByteUnits, 1024.0
+	if units == BytesSI {
+		names, base = siByteUnits, 1000.0
+	}
+
+	value, unit := float64(size), 0
+	for value >= base && unit < len(names)-1 {
+		value /= base
+		unit++
+	}
+
+	if unit == 0 {
+		return strconv.FormatUint(size, 10) + " " + names[0]
+	}
+
+	s := strconv.FormatFloat(value, 'f', max(precision, 0), 64)
+	if rounded, _ := strconv.ParseFloat(s, 64); rounded >= base && unit < len(names)-1 {
+		// Rounded up to the next unit, e.g. 1023.9 KiB to 1 MiB
+		value /= base
+		unit++
+		s = strconv.FormatFloat(value, 'f', max(precision, 0), 64)
+	}
+
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s + " " + names[unit]
+}
+
+// toBytesSize casts an interface to a byte size using the converter options.
+func toBytesSize(c *Converter, value any) (uint64, error) {
+	value = valueOf(value)
+	switch val := value.(type) {
+	case string:
+		return parseBytesSize(c, val)
+	case []byte:
+		return parseBytesSize(c, string(val))
+	case json.Number:
+		return parseBytesSize(c, string(val))
+	default:
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return 0, err
+			}
+			return parseBytesSize(c, text)
+		}
+		return toUnsigned[uint64](c, val)
+	}
+}
+
+// parseBytesSize parses a byte size string with an optional unit.
+func parseBytesSize(c *Converter, s string) (uint64, error) {
+	if c.isEmpty(s) {
+		return 0, c.emptyError("uint64")
+	}
+
+	s = strings.TrimSpace(s)
+	number := strings.TrimRightFunc(s, unicode.IsLetter)
+	multiplier, ok := byteMultipliers[strings.ToLower(s[len(number):])]
+	if !ok {
+		return 0, typeError("uint64")
+	}
+
+	r, ok := parseDecimal(strings.TrimSpace(c.normalizeNumber(number)))
+	if !ok {
+		return 0, typeError("uint64")
+	}
+	return unsignedFromRat[uint64](c, r.Mul(r, multiplier))
+}
@@ -0,0 +1,113 @@
+package gocast_test
+
+import (
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestToBytesSize(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected uint64
+	}{
+		{"512", 512},
+		{"512B", 512},
+		{"512MB", 512_000_000},
+		{"1.5GiB", 1_610_612_736},
+		{"1.5 gib", 1_610_612_736},
+		{"10k", 10_000},
+		{"2Ki", 2048},
+		{"1 EiB", 1 << 60},
+		{"3 bytes", 3},
+		{[]byte("1kb"), 1000},
+		{4096, 4096},
+		{2.0, 2},
+	}
+
+	for _, test := range tests {
+		if v, err := gocast.ToBytesSize(test.input); err != nil || v != test.expected {
+			t.Errorf("ToBytesSize(%v) = %v, %v, expected %v", test.input, v, err, test.expected)
+		}
+	}
+
+	for _, input := range []any{"abc", "10 XB", "1.5.5MB", "MB"} {
+		if _, err := gocast.ToBytesSize(input); !gocast.IsCastError(err) {
+			t.Errorf("ToBytesSize(%v) error = %v, expected cast error", input, err)
+		}
+	}
+
+	for _, input := range []any{"20EiB", "-1KB", -1} {
+		if _, err := gocast.ToBytesSize(input); !gocast.IsOverflowError(err) {
+			t.Errorf("ToBytesSize(%v) error = %v, expected overflow error", input, err)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		size      uint64
+		units     gocast.ByteUnits
+		precision int
+		expected  string
+	}{
+		{512, gocast.BytesIEC, 2, "512 B"},
+		{1536, gocast.BytesIEC, 2, "1.5 KiB"},
+		{1536, gocast.BytesSI, 2, "1.54 kB"},
+		{1_610_612_736, gocast.BytesIEC, 1, "1.5 GiB"},
+		{1_000_000, gocast.BytesSI, 3, "1 MB"},
+		{1_234_567, gocast.BytesSI, 0, "1 MB"},
+		{1_048_575, gocast.BytesIEC, 0, "1 MiB"},
+		{999_999, gocast.BytesSI, 2, "1 MB"},
+	}
+
+	for _, test := range tests {
+		if v := gocast.FormatBytes(test.size, test.units, test.precision); v != test.expected {
+			t.Errorf("FormatBytes(%d) = %q, expected %q", test.size, v, test.expected)
+		}
+	}
+
+	conv := gocast.NewConverter(gocast.WithBytesFormat(gocast.BytesIEC, 1))
+	if v, err := conv.ToString(uint64(3 << 20)); err != nil || v != "3 MiB" {
+		t.Errorf("ToString(3MiB) = %v, %v", v, err)
+	}
+	if v, err := conv.ToString(-2048); err != nil || v != "-2 KiB" {
+		t.Errorf("ToString(-2048) = %v, %v", v, err)
+	}
+}
+
+func TestSuffixMultipliers(t *testing.T) {
+	conv := gocast.NewConverter(gocast.WithSuffixMultipliers(nil))
+	if v, err := conv.ToInt("5k"); err != nil || v != 5000 {
+		t.Errorf("ToInt(5k) = %v, %v", v, err)
+	}
+	if v, err := conv.ToInt64("-1.5 M"); err != nil || v != -1_500_000 {
+		t.Errorf("ToInt64(-1.5 M) = %v, %v", v, err)
+	}
+	if v, err := conv.ToUint("2Ki"); err != nil || v != 2048 {
+		t.Errorf("ToUint(2Ki) = %v, %v", v, err)
+	}
+	if v, err := conv.ToFloat64("2.5k"); err != nil || v != 2500 {
+		t.Errorf("ToFloat64(2.5k) = %v, %v", v, err)
+	}
+	if v, err := conv.ToInt("42"); err != nil || v != 42 {
+		t.Errorf("ToInt(42) = %v, %v", v, err)
+	}
+	if _, err := conv.ToInt16("40k"); !gocast.IsOverflowError(err) {
+		t.Errorf("ToInt16(40k) error = %v, expected overflow error", err)
+	}
+	if _, err := conv.ToUint("-1k"); !gocast.IsOverflowError(err) {
+		t.Errorf("ToUint(-1k) error = %v, expected overflow error", err)
+	}
+	if _, err := conv.ToInt("5x"); !gocast.IsCastError(err) {
+		t.Errorf("ToInt(5x) error = %v, expected cast error", err)
+	}
+
+	custom := gocast.NewConverter(gocast.WithSuffixMultipliers(map[string]float64{"%": 0.01}))
+	if v, err := custom.ToFloat64("15%"); err != nil || v != 0.15 {
+		t.Errorf("ToFloat64(15%%) = %v, %v", v, err)
+	}
+	if _, err := gocast.ToSigned[int]("5k"); !gocast.IsCastError(err) {
+		t.Errorf("ToInt(5k) without multipliers error = %v, expected cast error", err)
+	}
+}
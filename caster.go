@@ -1,5 +1,13 @@
 package gocast
 
+import (
+	"iter"
+	"net/netip"
+	"net/url"
+)
+
+//go:generate go run ./cmd/gocastgen -core
+
 // Caster is an interface that provides methods for type casting and conversion.
 // It includes methods for checking if a value is nil, retrieving the value as an interface,
 // and converting the value to primary go types such as bool, int, uint, float, and string.
@@ -9,185 +17,75 @@ type Caster interface {
 	// IsNil checks if the value is nil.
 	IsNil() bool
 
+	// IsEmpty checks if the value is nil, a nil pointer, an empty or whitespace-only string,
+	// or an empty slice, array or map.
+	IsEmpty() bool
+
+	// IsZero checks if the value is empty or the zero value of its type (e.g. 0 or false).
+	IsZero() bool
+
 	// Interface returns the value as an interface{}.
 	Interface() any
 
 	// Unmarshal unmarshal value using json decoder.
 	Unmarshal(out any) error
 
-	// Bool converts the value to a bool.
-	Bool() (bool, error)
-
-	// BoolSafe converts the value to a bool, returning a fallback value in case of an error.
-	BoolSafe(fallback bool) bool
-
-	// Int converts the value to an int.
-	Int() (int, error)
-
-	// IntSafe converts the value to an int, returning a fallback value in case of an error.
-	IntSafe(fallback int) int
-
-	// Int8 converts the value to an int8.
-	Int8() (int8, error)
-
-	// Int8Safe converts the value to an int8, returning a fallback value in case of an error.
-	Int8Safe(fallback int8) int8
-
-	// Int16 converts the value to an int16.
-	Int16() (int16, error)
-
-	// Int16Safe converts the value to an int16, returning a fallback value in case of an error.
-	Int16Safe(fallback int16) int16
-
-	// Int32 converts the value to an int32.
-	Int32() (int32, error)
-
-	// Int32Safe converts the value to an int32, returning a fallback value in case of an error.
-	Int32Safe(fallback int32) int32
-
-	// Int64 converts the value to an int64.
-	Int64() (int64, error)
-
-	// Int64Safe converts the value to an int64, returning a fallback value in case of an error.
-	Int64Safe(fallback int64) int64
-
-	// Uint converts the value to a uint.
-	Uint() (uint, error)
-
-	// UintSafe converts the value to a uint, returning a fallback value in case of an error.
-	UintSafe(fallback uint) uint
-
-	// Uint8 converts the value to a uint8.
-	Uint8() (uint8, error)
-
-	// Uint8Safe converts the value to a uint8, returning a fallback value in case of an error.
-	Uint8Safe(fallback uint8) uint8
-
-	// Uint16 converts the value to a uint16.
-	Uint16() (uint16, error)
-
-	// Uint16Safe converts the value to a uint16, returning a fallback value in case of an error.
-	Uint16Safe(fallback uint16) uint16
-
-	// Uint32 converts the value to a uint32.
-	Uint32() (uint32, error)
-
-	// Uint32Safe converts the value to a uint32, returning a fallback value in case of an error.
-	Uint32Safe(fallback uint32) uint32
-
-	// Uint64 converts the value to a uint64.
-	Uint64() (uint64, error)
-
-	// Uint64Safe converts the value to a uint64, returning a fallback value in case of an error.
-	Uint64Safe(fallback uint64) uint64
-
-	// Float32 converts the value to a float32.
-	Float32() (float32, error)
-
-	// Float32Safe converts the value to a float32, returning a fallback value in case of an error.
-	Float32Safe(fallback float32) float32
-
-	// Float64 converts the value to a float64.
-	Float64() (float64, error)
-
-	// Float64Safe converts the value to a float64, returning a fallback value in case of an error.
-	Float64Safe(fallback float64) float64
-
-	// String converts the value to a string.
-	String() (string, error)
-
-	// StringSafe converts the value to a string, returning a fallback value in case of an error.
-	StringSafe(fallback string) string
-
-	// Slice returns the value as a slice of interface{}.
-	Slice() ([]any, error)
-
-	// Slice returns the value as a slice of interface{}.
-	SliceSafe(fallback []any) []any
-
-	// BoolSlice converts the value to a slice of bool.
-	BoolSlice() ([]bool, error)
-
-	// BoolSliceSafe converts the value to a slice of bool, returning a fallback value in case of an error.
-	BoolSliceSafe(fallback []bool) []bool
-
-	// IntSlice converts the value to a slice of int.
-	IntSlice() ([]int, error)
-
-	// IntSliceSafe converts the value to a slice of int, returning a fallback value in case of an error.
-	IntSliceSafe(fallback []int) []int
-
-	// Int8Slice converts the value to a slice of int8.
-	Int8Slice() ([]int8, error)
-
-	// Int8SliceSafe converts the value to a slice of int8, returning a fallback value in case of an error.
-	Int8SliceSafe(fallback []int8) []int8
-
-	// Int16Slice converts the value to a slice of int16.
-	Int16Slice() ([]int16, error)
-
-	// Int16SliceSafe converts the value to a slice of int16, returning a fallback value in case of an error.
-	Int16SliceSafe(fallback []int16) []int16
-
-	// Int32Slice converts the value to a slice of int32.
-	Int32Slice() ([]int32, error)
-
-	// Int32SliceSafe converts the value to a slice of int32, returning a fallback value in case of an error.
-	Int32SliceSafe(fallback []int32) []int32
-
-	// Int64Slice converts the value to a slice of int64.
-	Int64Slice() ([]int64, error)
-
-	// Int64SliceSafe converts the value to a slice of int64, returning a fallback value in case of an error.
-	Int64SliceSafe(fallback []int64) []int64
+	// Get returns a Caster for the nested value at the given dot separated path (e.g. "db.hosts.0" or "db.hosts[0]").
+	// Missing paths return a nil Caster.
+	Get(path string) Caster
 
-	// UintSlice converts the value to a slice of uint.
-	UintSlice() ([]uint, error)
+	// Pointer returns a Caster for the nested value at the given JSON Pointer (RFC 6901), e.g. "/items/0/id".
+	// Missing or invalid pointers return a nil Caster.
+	Pointer(pointer string) Caster
 
-	// UintSliceSafe converts the value to a slice of uint, returning a fallback value in case of an error.
-	UintSliceSafe(fallback []uint) []uint
+	// Query returns Casters for all values matching the JSONPath-style expression, e.g. "$.items[*].price",
+	// "$..id", "items[0:2]" or "items[?(@.active==true)].name". Filters compare values using the casting rules.
+	// Invalid expressions match no values (see ValidateQuery).
+	Query(expr string) []Caster
 
-	// Uint8Slice converts the value to a slice of uint8.
-	Uint8Slice() ([]uint8, error)
+	// QueryIter returns an iterator over the values matching the expression, see Query.
+	QueryIter(expr string) iter.Seq[Caster]
 
-	// Uint8SliceSafe converts the value to a slice of uint8, returning a fallback value in case of an error.
-	Uint8SliceSafe(fallback []uint8) []uint8
+	// With returns a Caster for the same value that converts using the provided options,
+	// e.g. caster.With(gocast.WithEmptyPolicy(gocast.PolicyZero)).Int().
+	With(opts ...Option) Caster
 
-	// Uint16Slice converts the value to a slice of uint16.
-	Uint16Slice() ([]uint16, error)
+	// Validate returns a fluent validator of the value, the path names the value in errors,
+	// e.g. caster.Validate("port").Int().Min(1).Max(65535).Value().
+	Validate(path string) *Validator
 
-	// Uint16SliceSafe converts the value to a slice of uint16, returning a fallback value in case of an error.
-	Uint16SliceSafe(fallback []uint16) []uint16
+	// Addr converts the value to a netip.Addr, e.g. "10.0.0.1".
+	Addr() (netip.Addr, error)
 
-	// Uint32Slice converts the value to a slice of uint32.
-	Uint32Slice() ([]uint32, error)
+	// AddrSlice converts the value to a []netip.Addr.
+	AddrSlice() ([]netip.Addr, error)
 
-	// Uint32SliceSafe converts the value to a slice of uint32, returning a fallback value in case of an error.
-	Uint32SliceSafe(fallback []uint32) []uint32
+	// Prefix converts the value to a netip.Prefix, e.g. "10.0.0.0/8".
+	Prefix() (netip.Prefix, error)
 
-	// Uint64Slice converts the value to a slice of uint64.
-	Uint64Slice() ([]uint64, error)
+	// PrefixSlice converts the value to a []netip.Prefix.
+	PrefixSlice() ([]netip.Prefix, error)
 
-	// Uint64SliceSafe converts the value to a slice of uint64, returning a fallback value in case of an error.
-	Uint64SliceSafe(fallback []uint64) []uint64
+	// AddrPort converts the value to a netip.AddrPort, e.g. "10.0.0.1:80".
+	AddrPort() (netip.AddrPort, error)
 
-	// Float32Slice converts the value to a slice of float32.
-	Float32Slice() ([]float32, error)
+	// AddrPortSlice converts the value to a []netip.AddrPort.
+	AddrPortSlice() ([]netip.AddrPort, error)
 
-	// Float32SliceSafe converts the value to a slice of float32, returning a fallback value in case of an error.
-	Float32SliceSafe(fallback []float32) []float32
+	// URL converts the value to a *url.URL.
+	URL() (*url.URL, error)
 
-	// Float64Slice converts the value to a slice of float64.
-	Float64Slice() ([]float64, error)
+	// URLSlice converts the value to a []*url.URL.
+	URLSlice() ([]*url.URL, error)
 
-	// Float64SliceSafe converts the value to a slice of float64, returning a fallback value in case of an error.
-	Float64SliceSafe(fallback []float64) []float64
+	// HostPort converts the value to a "host:port" string using the default port for values without port.
+	HostPort(defaultPort int) (string, error)
 
-	// StringSlice converts the value to a slice of string.
-	StringSlice() ([]string, error)
+	// HostPortSlice converts the value to a slice of "host:port" strings using the default port.
+	HostPortSlice(defaultPort int) ([]string, error)
 
-	// StringSliceSafe converts the value to a slice of string, returning a fallback value in case of an error.
-	StringSliceSafe(fallback []string) []string
+	// TypedCaster provides the primary types and slices conversion methods.
+	TypedCaster
 }
 
 // NewCaster creates a new instance of a Caster with the provided value.
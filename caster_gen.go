@@ -0,0 +1,266 @@
+// Code generated by gocastgen. DO NOT EDIT.
+
+package gocast
+
+// TypedCaster is an interface that provides methods for converting a value to primary go types
+// and slices of them. Each type conversion method has a corresponding safe method that returns
+// a fallback value in case of an error.
+type TypedCaster interface {
+	// Bool converts the value to a bool.
+	Bool() (bool, error)
+
+	// BoolSafe converts the value to a bool, returning a fallback value in case of an error.
+	BoolSafe(fallback bool) bool
+
+	// BoolOptional converts the value to an optional bool. Nil values result in an unset optional.
+	BoolOptional() (Optional[bool], error)
+
+	// BoolPtr converts the value to a bool pointer. Nil values result in a nil pointer.
+	BoolPtr() (*bool, error)
+
+	// Int converts the value to an int.
+	Int() (int, error)
+
+	// IntSafe converts the value to an int, returning a fallback value in case of an error.
+	IntSafe(fallback int) int
+
+	// IntOptional converts the value to an optional int. Nil values result in an unset optional.
+	IntOptional() (Optional[int], error)
+
+	// IntPtr converts the value to an int pointer. Nil values result in a nil pointer.
+	IntPtr() (*int, error)
+
+	// Int8 converts the value to an int8.
+	Int8() (int8, error)
+
+	// Int8Safe converts the value to an int8, returning a fallback value in case of an error.
+	Int8Safe(fallback int8) int8
+
+	// Int8Optional converts the value to an optional int8. Nil values result in an unset optional.
+	Int8Optional() (Optional[int8], error)
+
+	// Int8Ptr converts the value to an int8 pointer. Nil values result in a nil pointer.
+	Int8Ptr() (*int8, error)
+
+	// Int16 converts the value to an int16.
+	Int16() (int16, error)
+
+	// Int16Safe converts the value to an int16, returning a fallback value in case of an error.
+	Int16Safe(fallback int16) int16
+
+	// Int16Optional converts the value to an optional int16. Nil values result in an unset optional.
+	Int16Optional() (Optional[int16], error)
+
+	// Int16Ptr converts the value to an int16 pointer. Nil values result in a nil pointer.
+	Int16Ptr() (*int16, error)
+
+	// Int32 converts the value to an int32.
+	Int32() (int32, error)
+
+	// Int32Safe converts the value to an int32, returning a fallback value in case of an error.
+	Int32Safe(fallback int32) int32
+
+	// Int32Optional converts the value to an optional int32. Nil values result in an unset optional.
+	Int32Optional() (Optional[int32], error)
+
+	// Int32Ptr converts the value to an int32 pointer. Nil values result in a nil pointer.
+	Int32Ptr() (*int32, error)
+
+	// Int64 converts the value to an int64.
+	Int64() (int64, error)
+
+	// Int64Safe converts the value to an int64, returning a fallback value in case of an error.
+	Int64Safe(fallback int64) int64
+
+	// Int64Optional converts the value to an optional int64. Nil values result in an unset optional.
+	Int64Optional() (Optional[int64], error)
+
+	// Int64Ptr converts the value to an int64 pointer. Nil values result in a nil pointer.
+	Int64Ptr() (*int64, error)
+
+	// Uint converts the value to a uint.
+	Uint() (uint, error)
+
+	// UintSafe converts the value to a uint, returning a fallback value in case of an error.
+	UintSafe(fallback uint) uint
+
+	// UintOptional converts the value to an optional uint. Nil values result in an unset optional.
+	UintOptional() (Optional[uint], error)
+
+	// UintPtr converts the value to a uint pointer. Nil values result in a nil pointer.
+	UintPtr() (*uint, error)
+
+	// Uint8 converts the value to a uint8.
+	Uint8() (uint8, error)
+
+	// Uint8Safe converts the value to a uint8, returning a fallback value in case of an error.
+	Uint8Safe(fallback uint8) uint8
+
+	// Uint8Optional converts the value to an optional uint8. Nil values result in an unset optional.
+	Uint8Optional() (Optional[uint8], error)
+
+	// Uint8Ptr converts the value to a uint8 pointer. Nil values result in a nil pointer.
+	Uint8Ptr() (*uint8, error)
+
+	// Uint16 converts the value to a uint16.
+	Uint16() (uint16, error)
+
+	// Uint16Safe converts the value to a uint16, returning a fallback value in case of an error.
+	Uint16Safe(fallback uint16) uint16
+
+	// Uint16Optional converts the value to an optional uint16. Nil values result in an unset optional.
+	Uint16Optional() (Optional[uint16], error)
+
+	// Uint16Ptr converts the value to a uint16 pointer. Nil values result in a nil pointer.
+	Uint16Ptr() (*uint16, error)
+
+	// Uint32 converts the value to a uint32.
+	Uint32() (uint32, error)
+
+	// Uint32Safe converts the value to a uint32, returning a fallback value in case of an error.
+	Uint32Safe(fallback uint32) uint32
+
+	// Uint32Optional converts the value to an optional uint32. Nil values result in an unset optional.
+	Uint32Optional() (Optional[uint32], error)
+
+	// Uint32Ptr converts the value to a uint32 pointer. Nil values result in a nil pointer.
+	Uint32Ptr() (*uint32, error)
+
+	// Uint64 converts the value to a uint64.
+	Uint64() (uint64, error)
+
+	// Uint64Safe converts the value to a uint64, returning a fallback value in case of an error.
+	Uint64Safe(fallback uint64) uint64
+
+	// Uint64Optional converts the value to an optional uint64. Nil values result in an unset optional.
+	Uint64Optional() (Optional[uint64], error)
+
+	// Uint64Ptr converts the value to a uint64 pointer. Nil values result in a nil pointer.
+	Uint64Ptr() (*uint64, error)
+
+	// Float32 converts the value to a float32.
+	Float32() (float32, error)
+
+	// Float32Safe converts the value to a float32, returning a fallback value in case of an error.
+	Float32Safe(fallback float32) float32
+
+	// Float32Optional converts the value to an optional float32. Nil values result in an unset optional.
+	Float32Optional() (Optional[float32], error)
+
+	// Float32Ptr converts the value to a float32 pointer. Nil values result in a nil pointer.
+	Float32Ptr() (*float32, error)
+
+	// Float64 converts the value to a float64.
+	Float64() (float64, error)
+
+	// Float64Safe converts the value to a float64, returning a fallback value in case of an error.
+	Float64Safe(fallback float64) float64
+
+	// Float64Optional converts the value to an optional float64. Nil values result in an unset optional.
+	Float64Optional() (Optional[float64], error)
+
+	// Float64Ptr converts the value to a float64 pointer. Nil values result in a nil pointer.
+	Float64Ptr() (*float64, error)
+
+	// String converts the value to a string.
+	String() (string, error)
+
+	// StringSafe converts the value to a string, returning a fallback value in case of an error.
+	StringSafe(fallback string) string
+
+	// StringOptional converts the value to an optional string. Nil values result in an unset optional.
+	StringOptional() (Optional[string], error)
+
+	// StringPtr converts the value to a string pointer. Nil values result in a nil pointer.
+	StringPtr() (*string, error)
+
+	// Slice returns the value as a slice of interface{}.
+	Slice() ([]any, error)
+
+	// SliceSafe returns the value as a slice of interface{}, returning a fallback value in case of an error.
+	SliceSafe(fallback []any) []any
+
+	// BoolSlice converts the value to a slice of bool.
+	BoolSlice() ([]bool, error)
+
+	// BoolSliceSafe converts the value to a slice of bool, returning a fallback value in case of an error.
+	BoolSliceSafe(fallback []bool) []bool
+
+	// IntSlice converts the value to a slice of int.
+	IntSlice() ([]int, error)
+
+	// IntSliceSafe converts the value to a slice of int, returning a fallback value in case of an error.
+	IntSliceSafe(fallback []int) []int
+
+	// Int8Slice converts the value to a slice of int8.
+	Int8Slice() ([]int8, error)
+
+	// Int8SliceSafe converts the value to a slice of int8, returning a fallback value in case of an error.
+	Int8SliceSafe(fallback []int8) []int8
+
+	// Int16Slice converts the value to a slice of int16.
+	Int16Slice() ([]int16, error)
+
+	// Int16SliceSafe converts the value to a slice of int16, returning a fallback value in case of an error.
+	Int16SliceSafe(fallback []int16) []int16
+
+	// Int32Slice converts the value to a slice of int32.
+	Int32Slice() ([]int32, error)
+
+	// Int32SliceSafe converts the value to a slice of int32, returning a fallback value in case of an error.
+	Int32SliceSafe(fallback []int32) []int32
+
+	// Int64Slice converts the value to a slice of int64.
+	Int64Slice() ([]int64, error)
+
+	// Int64SliceSafe converts the value to a slice of int64, returning a fallback value in case of an error.
+	Int64SliceSafe(fallback []int64) []int64
+
+	// UintSlice converts the value to a slice of uint.
+	UintSlice() ([]uint, error)
+
+	// UintSliceSafe converts the value to a slice of uint, returning a fallback value in case of an error.
+	UintSliceSafe(fallback []uint) []uint
+
+	// Uint8Slice converts the value to a slice of uint8.
+	Uint8Slice() ([]uint8, error)
+
+	// Uint8SliceSafe converts the value to a slice of uint8, returning a fallback value in case of an error.
+	Uint8SliceSafe(fallback []uint8) []uint8
+
+	// Uint16Slice converts the value to a slice of uint16.
+	Uint16Slice() ([]uint16, error)
+
+	// Uint16SliceSafe converts the value to a slice of uint16, returning a fallback value in case of an error.
+	Uint16SliceSafe(fallback []uint16) []uint16
+
+	// Uint32Slice converts the value to a slice of uint32.
+	Uint32Slice() ([]uint32, error)
+
+	// Uint32SliceSafe converts the value to a slice of uint32, returning a fallback value in case of an error.
+	Uint32SliceSafe(fallback []uint32) []uint32
+
+	// Uint64Slice converts the value to a slice of uint64.
+	Uint64Slice() ([]uint64, error)
+
+	// Uint64SliceSafe converts the value to a slice of uint64, returning a fallback value in case of an error.
+	Uint64SliceSafe(fallback []uint64) []uint64
+
+	// Float32Slice converts the value to a slice of float32.
+	Float32Slice() ([]float32, error)
+
+	// Float32SliceSafe converts the value to a slice of float32, returning a fallback value in case of an error.
+	Float32SliceSafe(fallback []float32) []float32
+
+	// Float64Slice converts the value to a slice of float64.
+	Float64Slice() ([]float64, error)
+
+	// Float64SliceSafe converts the value to a slice of float64, returning a fallback value in case of an error.
+	Float64SliceSafe(fallback []float64) []float64
+
+	// StringSlice converts the value to a slice of string.
+	StringSlice() ([]string, error)
+
+	// StringSliceSafe converts the value to a slice of string, returning a fallback value in case of an error.
+	StringSliceSafe(fallback []string) []string
+}
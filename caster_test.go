@@ -26,6 +26,9 @@ func TestOverflowChecks(t *testing.T) {
 			if (err != nil) != test.err {
 				t.Errorf("ToSigned[int32](%v) error = %v, expected error = %v", test.input, err, test.err)
 			}
+			if test.err && !gocast.IsOverflowError(err) {
+				t.Errorf("ToSigned[int32](%v) error = %v, expected overflow error", test.input, err)
+			}
 			if result != test.expected {
 				t.Errorf("ToSigned[int32](%v) = %v, expected %v", test.input, result, test.expected)
 			}
@@ -34,6 +37,9 @@ func TestOverflowChecks(t *testing.T) {
 			if (err != nil) != test.err {
 				t.Errorf("ToUnsigned[uint32](%v) error = %v, expected error = %v", test.input, err, test.err)
 			}
+			if test.err && !gocast.IsOverflowError(err) {
+				t.Errorf("ToUnsigned[uint32](%v) error = %v, expected overflow error", test.input, err)
+			}
 			if result != test.expected {
 				t.Errorf("ToUnsigned[uint32](%v) = %v, expected %v", test.input, result, test.expected)
 			}
@@ -42,6 +48,9 @@ func TestOverflowChecks(t *testing.T) {
 			if (err != nil) != test.err {
 				t.Errorf("ToFloat[float32](%v) error = %v, expected error = %v", test.input, err, test.err)
 			}
+			if test.err && !gocast.IsOverflowError(err) {
+				t.Errorf("ToFloat[float32](%v) error = %v, expected overflow error", test.input, err)
+			}
 			if result != test.expected {
 				t.Errorf("ToFloat[float32](%v) = %v, expected %v", test.input, result, test.expected)
 			}
@@ -91,6 +100,9 @@ func TestToSigned(t *testing.T) {
 		{false, 0, false},
 		{1, 1, false},
 		{"123", 123, false},
+		{"1e3", 1000, false},
+		{"-12.7", -12, false},
+		{uint64(18446744073709551615), 0, true},
 		{36124544328881238, 0, true},
 		{"invalid", 0, true},
 		{nil, 0, true},
@@ -121,8 +133,12 @@ func TestToUnsigned(t *testing.T) {
 		{true, 1, false},
 		{false, 0, false},
 		{1, 1, false},
+		{0, 0, false},
 		{-1, 0, true},
 		{"123", 123, false},
+		{"0x10", 16, false},
+		{"12.7", 12, false},
+		{"-5", 0, true},
 		{"invalid", 0, true},
 		{nil, 0, true},
 	}
@@ -187,6 +203,8 @@ func TestToString(t *testing.T) {
 		{1, "1", false},
 		{0, "0", false},
 		{"hello", "hello", false},
+		{123.392, "123.392", false},
+		{float32(1.5), "1.5", false},
 		{nil, "", true},
 	}
 
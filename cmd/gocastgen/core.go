@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+// generateCore generates the Caster interface, caster driver and provider
+// declarations of the gocast package. It returns the file contents by file name.
+func generateCore() (map[string][]byte, error) {
+	res := make(map[string][]byte)
+	for name, tmpl := range coreTemplates {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, castTypes); err != nil {
+			return nil, err
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		res[name] = src
+	}
+	return res, nil
+}
+
+var coreFuncs = template.FuncMap{
+	"internal": internalFunc,
+}
+
+var coreTemplates = map[string]*template.Template{
+	"caster_gen.go":    template.Must(template.New("caster").Parse(casterTemplate)),
+	"converter_gen.go": template.Must(template.New("converter").Funcs(coreFuncs).Parse(converterTemplate)),
+	"driver_gen.go":    template.Must(template.New("driver").Parse(driverTemplate)),
+	"providers_gen.go": template.Must(template.New("providers").Parse(providersTemplate)),
+}
+
+// internalFunc returns the unexported implementation name of a conversion function,
+// e.g. toSigned[int] for ToSigned[int].
+func internalFunc(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+const casterTemplate = `// Code generated by gocastgen. DO NOT EDIT.
+
+package gocast
+
+// TypedCaster is an interface that provides methods for converting a value to primary go types
+// and slices of them. Each type conversion method has a corresponding safe method that returns
+// a fallback value in case of an error.
+type TypedCaster interface {
+{{- range . }}
+	// {{ .Name }} converts the value to {{ .Doc }}.
+	{{ .Name }}() ({{ .Type }}, error)
+
+	// {{ .Name }}Safe converts the value to {{ .Doc }}, returning a fallback value in case of an error.
+	{{ .Name }}Safe(fallback {{ .Type }}) {{ .Type }}
+
+	// {{ .Name }}Optional converts the value to an optional {{ .Type }}. Nil values result in an unset optional.
+	{{ .Name }}Optional() (Optional[{{ .Type }}], error)
+
+	// {{ .Name }}Ptr converts the value to {{ .Doc }} pointer. Nil values result in a nil pointer.
+	{{ .Name }}Ptr() (*{{ .Type }}, error)
+{{ end }}
+	// Slice returns the value as a slice of interface{}.
+	Slice() ([]any, error)
+
+	// SliceSafe returns the value as a slice of interface{}, returning a fallback value in case of an error.
+	SliceSafe(fallback []any) []any
+{{ range . }}
+	// {{ .Name }}Slice converts the value to a slice of {{ .Type }}.
+	{{ .Name }}Slice() ([]{{ .Type }}, error)
+
+	// {{ .Name }}SliceSafe converts the value to a slice of {{ .Type }}, returning a fallback value in case of an error.
+	{{ .Name }}SliceSafe(fallback []{{ .Type }}) []{{ .Type }}
+{{ end -}}
+}
+`
+
+const converterTemplate = `// Code generated by gocastgen. DO NOT EDIT.
+
+package gocast
+{{ range . }}
+// To{{ .Name }} casts an interface to {{ .Doc }} using the converter options.
+func (c *Converter) To{{ .Name }}(value any) ({{ .Type }}, error) {
+	return {{ internal .Func }}(c, value)
+}
+{{ end }}{{ range . }}
+// To{{ .Name }}Slice casts an interface to a slice of {{ .Type }} using the converter options.
+func (c *Converter) To{{ .Name }}Slice(value any) ([]{{ .Type }}, error) {
+	return {{ internal .SliceFunc }}(c, value)
+}
+{{ end -}}
+`
+
+const driverTemplate = `// Code generated by gocastgen. DO NOT EDIT.
+
+package gocast
+{{ range . }}
+func (driver casterDriver) {{ .Name }}() ({{ .Type }}, error) {
+	return driver.converter().To{{ .Name }}(driver.data)
+}
+
+func (driver casterDriver) {{ .Name }}Safe(fallback {{ .Type }}) {{ .Type }} {
+	val, err := driver.{{ .Name }}()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) {{ .Name }}Optional() (Optional[{{ .Type }}], error) {
+	return toOptional[{{ .Type }}](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) {{ .Name }}Ptr() (*{{ .Type }}, error) {
+	return toPtr[{{ .Type }}](driver.converter(), driver.data)
+}
+{{ end }}
+func (driver casterDriver) Slice() ([]any, error) {
+	return driver.converter().ToSlice(driver.data)
+}
+
+func (driver casterDriver) SliceSafe(fallback []any) []any {
+	val, err := driver.Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+{{ range . }}
+func (driver casterDriver) {{ .Name }}Slice() ([]{{ .Type }}, error) {
+	return driver.converter().To{{ .Name }}Slice(driver.data)
+}
+
+func (driver casterDriver) {{ .Name }}SliceSafe(fallback []{{ .Type }}) []{{ .Type }} {
+	val, err := driver.{{ .Name }}Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+{{ end -}}
+`
+
+const providersTemplate = `// Code generated by gocastgen. DO NOT EDIT.
+
+package gocast
+
+// SliceProvider is an interface that provides a method to return a slice of any type.
+type SliceProvider interface {
+	Slice() []any
+}
+
+// SliceErrorProvider is an interface that provides a method to return a slice of any type with an error.
+type SliceErrorProvider interface {
+	Slice() ([]any, error)
+}
+{{ range . }}
+// {{ .Name }}Provider is an interface that provides a method to return {{ .ValueDoc }}.
+type {{ .Name }}Provider interface {
+	{{ .Name }}() {{ .Type }}
+}
+
+// {{ .Name }}ErrorProvider is an interface that provides a method to return {{ .ValueDoc }} with an error.
+type {{ .Name }}ErrorProvider interface {
+	{{ .Name }}() ({{ .Type }}, error)
+}
+
+// {{ .Name }}SliceProvider is an interface that provides a method to return a slice of {{ .SliceDoc }}.
+type {{ .Name }}SliceProvider interface {
+	{{ .Name }}Slice() []{{ .Type }}
+}
+
+// {{ .Name }}SliceErrorProvider is an interface that provides a method to return a slice of {{ .SliceDoc }} with an error.
+type {{ .Name }}SliceErrorProvider interface {
+	{{ .Name }}Slice() ([]{{ .Type }}, error)
+}
+{{ end -}}
+`
@@ -0,0 +1,85 @@
+// Command gocastgen generates gocast boilerplate code.
+//
+// The core mode generates the TypedCaster interface, the caster driver methods and
+// the provider interfaces of the gocast package from its type table:
+//
+//	//go:generate go run ./cmd/gocastgen -core
+//
+// The type mode generates provider implementations and typed getters for
+// struct types of the package in the current directory:
+//
+//	//go:generate go run github.com/mekramy/gocast/cmd/gocastgen -type=Money,User
+//
+// Struct fields tagged with `gocast:"int64,string"` implement the corresponding
+// error provider interfaces (e.g. gocast.Int64ErrorProvider) by converting the field value.
+// A <Type>Getter struct with typed accessors for the fields is generated for every type,
+// fields are read from the key of the `cast` tag or the field name.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	core := flag.Bool("core", false, "generate the gocast package declarations")
+	typeNames := flag.String("type", "", "comma separated list of struct type names")
+	output := flag.String("output", "", "output file name; default <type>_gocast.go")
+	dir := flag.String("dir", ".", "package directory")
+	flag.Parse()
+
+	if err := run(*core, *typeNames, *output, *dir); err != nil {
+		fmt.Fprintln(os.Stderr, "gocastgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(core bool, typeNames, output, dir string) error {
+	if core {
+		files, err := generateCore()
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if typeNames == "" {
+		return fmt.Errorf("-core or -type flag is required")
+	}
+
+	names := strings.Split(typeNames, ",")
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+	}
+
+	pkg, files, err := parsePackage(dir)
+	if err != nil {
+		return err
+	}
+
+	src, err := generateTypes(pkg, files, names)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		output = strings.ToLower(names[0]) + "_gocast.go"
+	}
+	return os.WriteFile(filepath.Join(dir, output), src, 0o644)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCoreUpToDate(t *testing.T) {
+	files, err := generateCore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, src := range files {
+		current, err := os.ReadFile(filepath.Join("..", "..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(current, src) {
+			t.Errorf("%s is out of date, run go generate", name)
+		}
+	}
+}
+
+func TestGenerateTypes(t *testing.T) {
+	src := `package models
+
+type Money struct {
+	Cents    int64    ` + "`gocast:\"int64,float64\"`" + `
+	Currency string   ` + "`cast:\"currency\" gocast:\"string\"`" + `
+	Tags     []string
+	Meta     map[string]any
+	secret   string
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "models.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := generateTypes("models", []*ast.File{file}, []string{"Money"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		`import "github.com/mekramy/gocast"`,
+		"func (v Money) Int64() (int64, error) {\n\treturn gocast.ToSigned[int64](v.Cents)",
+		"func (v Money) Float64() (float64, error) {",
+		"func (v Money) String() (string, error) {\n\treturn gocast.ToString(v.Currency)",
+		"type MoneyGetter struct {",
+		"func (g MoneyGetter) Currency() (string, error) {\n\treturn g.caster.Get(\"currency\").String()",
+		"func (g MoneyGetter) Tags() ([]string, error) {\n\treturn g.caster.Get(\"Tags\").StringSlice()",
+		"func (g MoneyGetter) Meta() gocast.Caster {",
+		"func (g MoneyGetter) Decode() (Money, error) {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(string(out), e) {
+			t.Errorf("generated code does not contain %q\n%s", e, out)
+		}
+	}
+
+	if strings.Contains(string(out), "secret") {
+		t.Error("generated code must not contain unexported fields")
+	}
+
+	if _, err := generateTypes("models", []*ast.File{file}, []string{"Missing"}); err == nil {
+		t.Error("expected error for missing type")
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import "strings"
+
+// castType describes a primary type supported by the Caster interface and providers.
+type castType struct {
+	// Name is the method name, e.g. "Int8".
+	Name string
+	// Type is the go type, e.g. "int8".
+	Type string
+	// Func is the package converter, e.g. "ToSigned[int8]".
+	Func string
+	// SliceFunc is the package slice converter, e.g. "ToSignedSlice[int8]".
+	SliceFunc string
+	// Doc is the type description used by caster docs, e.g. "an int8".
+	Doc string
+	// ValueDoc is the value description used by provider docs, e.g. "an int8 value".
+	ValueDoc string
+	// SliceDoc is the slice description used by provider docs, e.g. "int8 values".
+	SliceDoc string
+}
+
+// castTypes is the type table used to generate the Caster interface,
+// the caster driver and the provider interfaces.
+var castTypes = []castType{
+	{"Bool", "bool", "ToBool", "ToBoolSlice", "a bool", "a boolean value", "boolean values"},
+	{"Int", "int", "ToSigned[int]", "ToSignedSlice[int]", "an int", "an integer value", "integer values"},
+	{"Int8", "int8", "ToSigned[int8]", "ToSignedSlice[int8]", "an int8", "an int8 value", "int8 values"},
+	{"Int16", "int16", "ToSigned[int16]", "ToSignedSlice[int16]", "an int16", "an int16 value", "int16 values"},
+	{"Int32", "int32", "ToSigned[int32]", "ToSignedSlice[int32]", "an int32", "an int32 value", "int32 values"},
+	{"Int64", "int64", "ToSigned[int64]", "ToSignedSlice[int64]", "an int64", "an int64 value", "int64 values"},
+	{"Uint", "uint", "ToUnsigned[uint]", "ToUnsignedSlice[uint]", "a uint", "a uint value", "uint values"},
+	{"Uint8", "uint8", "ToUnsigned[uint8]", "ToUnsignedSlice[uint8]", "a uint8", "a uint8 value", "uint8 values"},
+	{"Uint16", "uint16", "ToUnsigned[uint16]", "ToUnsignedSlice[uint16]", "a uint16", "a uint16 value", "uint16 values"},
+	{"Uint32", "uint32", "ToUnsigned[uint32]", "ToUnsignedSlice[uint32]", "a uint32", "a uint32 value", "uint32 values"},
+	{"Uint64", "uint64", "ToUnsigned[uint64]", "ToUnsignedSlice[uint64]", "a uint64", "a uint64 value", "uint64 values"},
+	{"Float32", "float32", "ToFloat[float32]", "ToFloatSlice[float32]", "a float32", "a float32 value", "float32 values"},
+	{"Float64", "float64", "ToFloat[float64]", "ToFloatSlice[float64]", "a float64", "a float64 value", "float64 values"},
+	{"String", "string", "ToString", "ToStringSlice", "a string", "a string value", "string values"},
+}
+
+// findType finds a type of the table by go type, e.g. "int8".
+func findType(goType string) (castType, bool) {
+	for _, t := range castTypes {
+		if t.Type == goType {
+			return t, true
+		}
+	}
+	return castType{}, false
+}
+
+// findTypeByName finds a type of the table by method name (case-insensitive), e.g. "int8".
+func findTypeByName(name string) (castType, bool) {
+	for _, t := range castTypes {
+		if strings.EqualFold(t.Name, name) {
+			return t, true
+		}
+	}
+	return castType{}, false
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// userType describes a struct type to generate providers and getter for.
+type userType struct {
+	Name      string
+	Fields    []userField
+	Providers []userProvider
+}
+
+// userField describes a getter method of a struct field.
+type userField struct {
+	Name string
+	Key  string
+	// Method is the Caster method, empty for fields without a primary type.
+	Method string
+	// Type is the return type of the getter method.
+	Type string
+}
+
+// userProvider describes a provider method implemented by a struct field.
+type userProvider struct {
+	Field string
+	Type  castType
+}
+
+// parsePackage parses the non-test go files of the directory.
+func parsePackage(dir string) (string, []*ast.File, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+
+	fset := token.NewFileSet()
+	pkg := ""
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if pkg == "" {
+			pkg = file.Name.Name
+		}
+		files = append(files, file)
+	}
+
+	if pkg == "" {
+		return "", nil, fmt.Errorf("no go files found in %s", dir)
+	}
+	return pkg, files, nil
+}
+
+// generateTypes generates provider implementations and typed getters for the named struct types.
+func generateTypes(pkg string, files []*ast.File, names []string) ([]byte, error) {
+	specs := make(map[string]*ast.StructType)
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			if spec, ok := n.(*ast.TypeSpec); ok {
+				if st, ok := spec.Type.(*ast.StructType); ok {
+					specs[spec.Name.Name] = st
+				}
+			}
+			return true
+		})
+	}
+
+	data := make([]userType, 0, len(names))
+	for _, name := range names {
+		st, ok := specs[name]
+		if !ok {
+			return nil, fmt.Errorf("struct type %s not found", name)
+		}
+
+		typ, err := parseUserType(name, st)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, typ)
+	}
+
+	qualifier := "gocast."
+	if pkg == "gocast" {
+		qualifier = ""
+	}
+
+	var buf bytes.Buffer
+	err := userTemplate.Execute(&buf, map[string]any{
+		"Package":   pkg,
+		"Qualifier": qualifier,
+		"Types":     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// parseUserType collects the getter fields and provider methods of a struct type.
+func parseUserType(name string, st *ast.StructType) (userType, error) {
+	res := userType{Name: name}
+	providers := make(map[string]string)
+	for _, field := range st.Fields.List {
+		var tag reflect.StructTag
+		if field.Tag != nil {
+			raw, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return res, err
+			}
+			tag = reflect.StructTag(raw)
+		}
+
+		goType := types.ExprString(field.Type)
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			// Getter
+			key, _, _ := strings.Cut(tag.Get("cast"), ",")
+			if key == "-" {
+				continue
+			} else if key == "" {
+				key = ident.Name
+			}
+
+			if ident.Name != "Decode" {
+				res.Fields = append(res.Fields, getterField(ident.Name, key, goType))
+			}
+
+			// Providers
+			for _, kind := range strings.Split(tag.Get("gocast"), ",") {
+				if kind = strings.TrimSpace(kind); kind == "" {
+					continue
+				}
+
+				t, ok := findTypeByName(kind)
+				if !ok {
+					return res, fmt.Errorf("%s.%s: unknown provider type %q", name, ident.Name, kind)
+				} else if other, ok := providers[t.Name]; ok {
+					return res, fmt.Errorf("%s.%s: %s provider is already implemented by %s", name, ident.Name, t.Name, other)
+				}
+
+				providers[t.Name] = ident.Name
+				res.Providers = append(res.Providers, userProvider{Field: ident.Name, Type: t})
+			}
+		}
+	}
+	return res, nil
+}
+
+// getterField resolves the Caster method of a field type.
+func getterField(name, key, goType string) userField {
+	if t, ok := findType(goType); ok {
+		return userField{Name: name, Key: key, Method: t.Name, Type: t.Type}
+	}
+
+	if elem, ok := strings.CutPrefix(goType, "[]"); ok {
+		if t, ok := findType(elem); ok {
+			return userField{Name: name, Key: key, Method: t.Name + "Slice", Type: "[]" + t.Type}
+		}
+	}
+	return userField{Name: name, Key: key}
+}
+
+var userTemplate = template.Must(template.New("user").Parse(`// Code generated by gocastgen. DO NOT EDIT.
+
+package {{ .Package }}
+{{ if .Qualifier }}
+import "github.com/mekramy/gocast"
+{{ end }}
+{{- $q := .Qualifier }}
+{{- range .Types }}
+{{- $type := .Name }}
+{{- range .Providers }}
+// {{ .Type.Name }} implements the {{ $q }}{{ .Type.Name }}ErrorProvider interface using the {{ .Field }} field.
+func (v {{ $type }}) {{ .Type.Name }}() ({{ .Type.Type }}, error) {
+	return {{ $q }}{{ .Type.Func }}(v.{{ .Field }})
+}
+{{ end }}
+// {{ .Name }}Getter provides typed access to {{ .Name }} fields of a {{ $q }}Caster document.
+type {{ .Name }}Getter struct {
+	caster {{ $q }}Caster
+}
+
+// New{{ .Name }}Getter creates a new {{ .Name }}Getter for the document.
+func New{{ .Name }}Getter(caster {{ $q }}Caster) {{ .Name }}Getter {
+	return {{ .Name }}Getter{caster: caster}
+}
+{{ range .Fields }}
+{{- if .Method }}
+// {{ .Name }} returns the "{{ .Key }}" field as {{ .Type }}.
+func (g {{ $type }}Getter) {{ .Name }}() ({{ .Type }}, error) {
+	return g.caster.Get("{{ .Key }}").{{ .Method }}()
+}
+{{ else }}
+// {{ .Name }} returns the "{{ .Key }}" field as Caster.
+func (g {{ $type }}Getter) {{ .Name }}() {{ $q }}Caster {
+	return g.caster.Get("{{ .Key }}")
+}
+{{ end }}
+{{- end }}
+// Decode decodes the document into a {{ .Name }}.
+func (g {{ .Name }}Getter) Decode() ({{ .Name }}, error) {
+	var res {{ .Name }}
+	err := {{ $q }}Decode(g.caster.Interface(), &res)
+	return res, err
+}
+{{ end -}}
+`))
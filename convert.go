@@ -0,0 +1,481 @@
+package gocast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConvertOptions configures Convert.
+type ConvertOptions struct {
+	// Tag is the struct tag used for naming, defaults to "cast" as in Decode.
+	Tag string
+	// Renames maps destination field paths to source field names,
+	// e.g. {"owner.id": "UserID"} fills the id field of the owner from its UserID source field.
+	// Paths omit slice indexes, e.g. "items.id" applies to all items.
+	Renames map[string]string
+	// Ignore lists destination field paths that are not converted, slice indexes are omitted.
+	Ignore []string
+
+	// sources are the destination keys of the renamed source fields, built once per Convert.
+	sources map[renameSource]string
+}
+
+// renameSource identifies a renamed source field by the path of its parent.
+type renameSource struct {
+	parent string
+	name   string
+}
+
+// tag returns the struct tag or the default one.
+func (o ConvertOptions) tag() string {
+	if o.Tag == "" {
+		return "cast"
+	}
+	return o.Tag
+}
+
+// withSources returns the options with the reverse lookup of the renames.
+func (o ConvertOptions) withSources() ConvertOptions {
+	o.sources = make(map[renameSource]string, len(o.Renames))
+	for dst, src := range o.Renames {
+		parent, key := "", dst
+		if i := strings.LastIndexByte(dst, '.'); i >= 0 {
+			parent, key = dst[:i], dst[i+1:]
+		}
+		o.sources[renameSource{parent: parent, name: src}] = key
+	}
+	return o
+}
+
+// renamedKey returns the destination key of the source field at the parent path,
+// the renamed key or the field name.
+func (o ConvertOptions) renamedKey(parent string, field structField) string {
+	pattern := withoutIndexes(parent)
+	if key, ok := o.sources[renameSource{parent: pattern, name: field.name}]; ok {
+		return key
+	} else if key, ok := o.sources[renameSource{parent: pattern, name: field.field.Name}]; ok {
+		return key
+	}
+	return field.name
+}
+
+// ignored checks if the destination path is ignored.
+func (o ConvertOptions) ignored(path string) bool {
+	for _, item := range o.Ignore {
+		if item == path {
+			return true
+		}
+	}
+	return false
+}
+
+// convertKey identifies the field plan of a struct type pair.
+type convertKey struct {
+	src reflect.Type
+	dst reflect.Type
+	tag string
+}
+
+// convertPlan is the cached field matching of a struct type pair.
+type convertPlan struct {
+	dst []structField
+	src []structField
+	// matches are the indexes of the source fields matching the destination fields by name, or -1.
+	matches []int
+}
+
+// convertPlans caches the field plans of converted struct type pairs and the fields of
+// struct types converted from or to maps.
+var convertPlans sync.Map
+
+// Convert converts the src value into the value pointed by dst. Struct fields are
+// matched by the `cast` tag (or the Tag option) or the field name (case-insensitive)
+// and converted using the package converters. Nested structs, slices and maps are
+// converted recursively, structs are converted to maps and vice versa.
+// Tag, Renames and Ignore apply to map keys in both directions.
+// Time values are converted to unix seconds for numeric fields. Values nested deeper
+// than the WithMaxDepth limit (1000 by default) fail with a limit error.
+// Field plans are cached per struct type pair. Errors of all fields are
+// reported as FieldErrors with the paths of the destination fields.
+func Convert(src any, dst any, opts ConvertOptions) error {
+	return defaultConverter.Convert(src, dst, opts)
+}
+
+// Convert converts the src value into the value pointed by dst using the converter options.
+func (c *Converter) Convert(src any, dst any, opts ConvertOptions) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("convert target must be a non-nil pointer")
+	}
+
+	var errs FieldErrors
+	convertValue(c, reflect.ValueOf(src), rv.Elem(), "", opts.withSources(), &errs, 0)
+	if len(errs) == 1 && errs[0].Path == "" {
+		return errs[0].Err
+	} else if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// convertValue converts the in value into the settable out value, appending errors to errs.
+func convertValue(c *Converter, in reflect.Value, out reflect.Value, path string, opts ConvertOptions, errs *FieldErrors, depth int) {
+	if limit := c.convertDepth(); depth > limit {
+		appendFieldError(errs, path, limitError(fmt.Sprintf("%d nesting levels", limit)))
+		return
+	}
+
+	for in.Kind() == reflect.Ptr || in.Kind() == reflect.Interface {
+		if in.IsNil() {
+			return
+		}
+		in = in.Elem()
+	}
+
+	if !in.IsValid() {
+		return
+	}
+
+	// Allocate pointers
+	if out.Kind() == reflect.Ptr {
+		if _, ok := c.options.registry.lookup(out.Type()); !ok {
+			if out.IsNil() {
+				out.Set(reflect.New(out.Type().Elem()))
+			}
+			convertValue(c, in, out.Elem(), path, opts, errs, depth)
+			return
+		}
+	}
+
+	var err error
+	_, custom := c.options.registry.lookup(out.Type())
+	switch {
+	case custom, out.Type() == timeType, implementsUnmarshaler(out):
+		err = decodeValue(c, in.Interface(), out, path)
+	case in.Type() == timeType && isNumericKind(out.Kind()):
+		t := in.Interface().(time.Time)
+		if out.Kind() == reflect.Float32 || out.Kind() == reflect.Float64 {
+			err = decodeValue(c, float64(t.UnixNano())/1e9, out, path)
+		} else {
+			err = decodeValue(c, t.Unix(), out, path)
+		}
+	case in.Kind() == reflect.Struct && out.Kind() == reflect.Struct:
+		if in.Type().AssignableTo(out.Type()) && len(opts.Renames) == 0 && len(opts.Ignore) == 0 {
+			out.Set(in)
+			return
+		}
+		convertStruct(c, in, out, path, opts, errs, depth)
+		return
+	case in.Kind() == reflect.Struct && out.Kind() == reflect.Map:
+		err = decodeValue(c, structToMap(c, in, path, opts, errs, depth), out, path)
+	case in.Kind() == reflect.Map && out.Kind() == reflect.Struct && in.Type().Key().Kind() == reflect.String:
+		convertMapToStruct(c, in, out, path, opts, errs, depth)
+		return
+	case (in.Kind() == reflect.Slice || in.Kind() == reflect.Array) && (out.Kind() == reflect.Slice || out.Kind() == reflect.Array) &&
+		in.Type().Elem().Kind() != reflect.Uint8:
+		convertSlice(c, in, out, path, opts, errs, depth)
+		return
+	case in.Kind() == reflect.Map && out.Kind() == reflect.Map:
+		convertMap(c, in, out, path, opts, errs, depth)
+		return
+	default:
+		err = decodeValue(c, in.Interface(), out, path)
+	}
+
+	appendFieldError(errs, path, err)
+}
+
+// convertStruct converts the fields of the in struct into the out struct.
+func convertStruct(c *Converter, in reflect.Value, out reflect.Value, path string, opts ConvertOptions, errs *FieldErrors, depth int) {
+	plan := planOf(in.Type(), out.Type(), opts.tag())
+	for i, field := range plan.dst {
+		fieldPath := joinPath(path, field.name)
+		pattern := withoutIndexes(fieldPath)
+		if opts.ignored(pattern) {
+			continue
+		}
+
+		match := plan.matches[i]
+		if name, ok := opts.Renames[pattern]; ok {
+			match = matchField(plan.src, name)
+		}
+
+		if match < 0 {
+			continue
+		}
+
+		source, ok := fieldByIndex(in, plan.src[match].index)
+		if !ok {
+			continue
+		}
+		convertValue(c, source, out.FieldByIndex(field.index), fieldPath, opts, errs, depth+1)
+	}
+}
+
+// convertSlice converts the items of the in slice or array into the out slice or array.
+func convertSlice(c *Converter, in reflect.Value, out reflect.Value, path string, opts ConvertOptions, errs *FieldErrors, depth int) {
+	if in.Kind() == reflect.Slice && in.IsNil() {
+		return
+	}
+
+	size := in.Len()
+	if out.Kind() == reflect.Slice {
+		out.Set(reflect.MakeSlice(out.Type(), size, size))
+	} else {
+		size = min(size, out.Len())
+	}
+
+	for i := 0; i < size; i++ {
+		convertValue(c, in.Index(i), out.Index(i), indexPath(path, i), opts, errs, depth+1)
+	}
+}
+
+// convertMap converts the entries of the in map into the out map.
+func convertMap(c *Converter, in reflect.Value, out reflect.Value, path string, opts ConvertOptions, errs *FieldErrors, depth int) {
+	if in.IsNil() {
+		return
+	}
+
+	res := reflect.MakeMapWithSize(out.Type(), in.Len())
+	iter := in.MapRange()
+	for iter.Next() {
+		name, _ := ToString(iter.Key().Interface())
+		entryPath := joinPath(path, name)
+
+		key := reflect.New(out.Type().Key()).Elem()
+		if err := decodeValue(c, iter.Key().Interface(), key, entryPath); err != nil {
+			appendFieldError(errs, entryPath, err)
+			continue
+		}
+
+		value := reflect.New(out.Type().Elem()).Elem()
+		count := len(*errs)
+		convertValue(c, iter.Value(), value, entryPath, opts, errs, depth+1)
+		if len(*errs) == count {
+			res.SetMapIndex(key, value)
+		}
+	}
+	out.Set(res)
+}
+
+// convertMapToStruct converts the entries of the in map into the fields of the out struct.
+// Entries are matched by the tag or field names (case-insensitive) and the renames.
+func convertMapToStruct(c *Converter, in reflect.Value, out reflect.Value, path string, opts ConvertOptions, errs *FieldErrors, depth int) {
+	if in.IsNil() {
+		return
+	}
+
+	for _, field := range fieldsOf(out.Type(), opts.tag()) {
+		fieldPath := joinPath(path, field.name)
+		pattern := withoutIndexes(fieldPath)
+		if opts.ignored(pattern) {
+			continue
+		}
+
+		key := field.name
+		if name, ok := opts.Renames[pattern]; ok {
+			key = name
+		}
+
+		value, ok := mapLookup(in, key)
+		if !ok {
+			continue
+		}
+		convertValue(c, reflect.ValueOf(value), out.FieldByIndex(field.index), fieldPath, opts, errs, depth+1)
+	}
+}
+
+// structToMap converts the fields of the struct into a map keyed by the tag or field names
+// and the renames. Nested structs and slices are converted recursively, other values are
+// encoded as in Encode. The omitempty, inline and string tag options are applied.
+func structToMap(c *Converter, in reflect.Value, path string, opts ConvertOptions, errs *FieldErrors, depth int) map[string]any {
+	res := make(map[string]any)
+	var inlined []map[string]any
+	for _, field := range fieldsOf(in.Type(), opts.tag()) {
+		source, ok := fieldByIndex(in, field.index)
+		if !ok || (hasOption(field.options, "omitempty") && isEmptyField(source)) {
+			continue
+		}
+
+		key := opts.renamedKey(path, field)
+		fieldPath := joinPath(path, key)
+		if opts.ignored(withoutIndexes(fieldPath)) {
+			continue
+		}
+
+		inline := hasOption(field.options, "inline") || hasOption(field.options, "squash")
+		if inline {
+			fieldPath = path
+		}
+
+		value := convertToAny(c, source, fieldPath, opts, errs, depth+1)
+		if hasOption(field.options, "string") {
+			var err error
+			if value, err = stringifyValue(value, fieldPath); err != nil {
+				appendFieldError(errs, fieldPath, err)
+				continue
+			}
+		}
+
+		// Inline nested structs and maps, nil values have no fields to inline
+		if inline {
+			if value == nil {
+				continue
+			} else if nested, ok := value.(map[string]any); ok {
+				inlined = append(inlined, nested)
+				continue
+			}
+		}
+		res[key] = value
+	}
+	mergeInlined(res, inlined)
+	return res
+}
+
+// convertToAny converts the value for a map[string]any entry, structs are converted to maps.
+func convertToAny(c *Converter, in reflect.Value, path string, opts ConvertOptions, errs *FieldErrors, depth int) any {
+	if limit := c.convertDepth(); depth > limit {
+		appendFieldError(errs, path, limitError(fmt.Sprintf("%d nesting levels", limit)))
+		return nil
+	}
+
+	for in.Kind() == reflect.Ptr || in.Kind() == reflect.Interface {
+		if in.IsNil() {
+			return nil
+		}
+		in = in.Elem()
+	}
+
+	switch {
+	case !in.IsValid():
+		return nil
+	case in.Kind() == reflect.Struct && in.Type() != timeType && !reflect.PointerTo(in.Type()).Implements(textMarshalerType):
+		return structToMap(c, in, path, opts, errs, depth)
+	case (in.Kind() == reflect.Slice || in.Kind() == reflect.Array) && in.Type().Elem().Kind() != reflect.Uint8:
+		if in.Kind() == reflect.Slice && in.IsNil() {
+			return nil
+		}
+
+		items := make([]any, in.Len())
+		for i := range items {
+			items[i] = convertToAny(c, in.Index(i), indexPath(path, i), opts, errs, depth+1)
+		}
+		return items
+	default:
+		v, err := encodeValue(in, EncodeOptions{Tag: opts.tag()}, path, depth)
+		appendFieldError(errs, path, err)
+		return v
+	}
+}
+
+// convertDepth returns the nesting limit of converted values.
+func (c *Converter) convertDepth() int {
+	if c.options.maxDepth > 0 {
+		return c.options.maxDepth
+	}
+	return defaultMaxDepth
+}
+
+// planOf returns the cached field plan of the struct type pair.
+func planOf(src, dst reflect.Type, tag string) *convertPlan {
+	key := convertKey{src: src, dst: dst, tag: tag}
+	if plan, ok := convertPlans.Load(key); ok {
+		return plan.(*convertPlan)
+	}
+
+	plan := &convertPlan{
+		dst: fieldsOf(dst, tag),
+		src: fieldsOf(src, tag),
+	}
+
+	plan.matches = make([]int, len(plan.dst))
+	for i, field := range plan.dst {
+		plan.matches[i] = matchField(plan.src, field.name)
+	}
+
+	actual, _ := convertPlans.LoadOrStore(key, plan)
+	return actual.(*convertPlan)
+}
+
+// fieldsOf returns the cached fields of the struct type, stored as a plan without source type.
+func fieldsOf(typ reflect.Type, tag string) []structField {
+	key := convertKey{dst: typ, tag: tag}
+	if plan, ok := convertPlans.Load(key); ok {
+		return plan.(*convertPlan).dst
+	}
+
+	actual, _ := convertPlans.LoadOrStore(key, &convertPlan{dst: structFields(typ, tag)})
+	return actual.(*convertPlan).dst
+}
+
+// matchField returns the index of the field with the name (exact or case-insensitive), or -1.
+func matchField(fields []structField, name string) int {
+	fold := -1
+	for i, field := range fields {
+		if field.name == name || field.field.Name == name {
+			return i
+		} else if fold < 0 && strings.EqualFold(field.name, name) {
+			fold = i
+		}
+	}
+	return fold
+}
+
+// fieldByIndex returns the nested field of the struct, or false if it is reached through a nil pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	field, err := v.FieldByIndexErr(index)
+	return field, err == nil
+}
+
+// implementsUnmarshaler checks if the value is decoded by the encoding.TextUnmarshaler interface.
+func implementsUnmarshaler(v reflect.Value) bool {
+	return v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType)
+}
+
+// isNumericKind checks if the kind is an integer or float kind.
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// withoutIndexes removes the slice indexes of the path, e.g. "items[0].id" to "items.id".
+func withoutIndexes(path string) string {
+	if !strings.Contains(path, "[") {
+		return path
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '[' {
+			if end := strings.IndexByte(path[i:], ']'); end > 0 {
+				i += end
+				continue
+			}
+		}
+		sb.WriteByte(path[i])
+	}
+	return sb.String()
+}
+
+// appendFieldError appends the error as a field error of the path.
+func appendFieldError(errs *FieldErrors, path string, err error) {
+	if err == nil {
+		return
+	}
+
+	var fe *FieldError
+	if !errors.As(err, &fe) {
+		fe = &FieldError{Path: path, Err: err}
+	}
+	*errs = append(*errs, fe)
+}
@@ -0,0 +1,179 @@
+package gocast_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+type convertOwnerDTO struct {
+	UserID string
+	Name   string
+}
+
+type convertOrderDTO struct {
+	ID       string            `cast:"id"`
+	Total    string            `cast:"total"`
+	Created  time.Time         `cast:"created"`
+	Owner    *convertOwnerDTO  `cast:"owner"`
+	Items    []convertOwnerDTO `cast:"items"`
+	Counts   map[string]string `cast:"counts"`
+	Internal string            `cast:"internal"`
+	Note     string
+}
+
+type convertOwner struct {
+	ID   int64  `cast:"id"`
+	Name string `cast:"name"`
+}
+
+type convertOrder struct {
+	ID       int64           `cast:"id"`
+	Total    float64         `cast:"total"`
+	Created  int64           `cast:"created"`
+	Owner    convertOwner    `cast:"owner"`
+	Items    []*convertOwner `cast:"items"`
+	Counts   map[string]int  `cast:"counts"`
+	Internal string          `cast:"internal"`
+	Comment  string          `cast:"comment"`
+	Extra    map[string]any  `cast:"extra"`
+}
+
+func TestConvert(t *testing.T) {
+	src := convertOrderDTO{
+		ID:       "42",
+		Total:    "19.5",
+		Created:  time.Unix(1700000000, 0),
+		Owner:    &convertOwnerDTO{UserID: "7", Name: "admin"},
+		Items:    []convertOwnerDTO{{UserID: "1", Name: "a"}},
+		Counts:   map[string]string{"x": "3"},
+		Internal: "secret",
+		Note:     "hello",
+	}
+
+	opts := gocast.ConvertOptions{
+		Renames: map[string]string{"owner.id": "UserID", "items.id": "UserID", "comment": "Note"},
+		Ignore:  []string{"internal"},
+	}
+
+	var dst convertOrder
+	if err := gocast.Convert(src, &dst, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := convertOrder{
+		ID:      42,
+		Total:   19.5,
+		Created: 1700000000,
+		Owner:   convertOwner{ID: 7, Name: "admin"},
+		Items:   []*convertOwner{{ID: 1, Name: "a"}},
+		Counts:  map[string]int{"x": 3},
+		Comment: "hello",
+	}
+	if !reflect.DeepEqual(dst, expected) {
+		t.Errorf("Convert() = %+v, expected %+v", dst, expected)
+	}
+
+	// Struct to map
+	var m map[string]any
+	if err := gocast.Convert(src.Owner, &m, gocast.ConvertOptions{}); err != nil || m["UserID"] != "7" {
+		t.Errorf("Convert(struct, map) = %v, %v", m, err)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	src := convertOrderDTO{
+		ID:     "abc",
+		Total:  "x",
+		Counts: map[string]string{"a": "1", "b": "y"},
+	}
+
+	var dst convertOrder
+	err := gocast.Convert(src, &dst, gocast.ConvertOptions{})
+
+	var errs gocast.FieldErrors
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("Convert() error = %v, expected 3 field errors", err)
+	}
+
+	paths := []string{errs[0].Path, errs[1].Path, errs[2].Path}
+	if !reflect.DeepEqual(paths, []string{"id", "total", "counts.b"}) || !gocast.IsCastError(errs[0]) {
+		t.Errorf("Convert() error paths = %v", paths)
+	}
+
+	if dst.Counts["a"] != 1 {
+		t.Errorf("Convert() valid entries not converted, counts = %v", dst.Counts)
+	}
+
+	var n int
+	if err := gocast.Convert("abc", &n, gocast.ConvertOptions{}); !gocast.IsCastError(err) {
+		t.Errorf("Convert(scalar) error = %v, expected cast error", err)
+	}
+
+	if err := gocast.Convert(src, dst, gocast.ConvertOptions{}); err == nil {
+		t.Errorf("Convert() to non-pointer expected error")
+	}
+}
+
+func TestConvertMapStruct(t *testing.T) {
+	type account struct {
+		UID    int    `json:"user_id"`
+		Name   string `json:"name"`
+		Secret string `json:"secret"`
+	}
+
+	src := map[string]any{"user_id": "5", "login": "admin", "secret": "x"}
+	opts := gocast.ConvertOptions{Tag: "json", Renames: map[string]string{"name": "login"}, Ignore: []string{"secret"}}
+
+	var dst account
+	if err := gocast.Convert(src, &dst, opts); err != nil || dst != (account{UID: 5, Name: "admin"}) {
+		t.Errorf("Convert(map, struct) = %+v, %v", dst, err)
+	}
+
+	var m map[string]any
+	err := gocast.Convert(account{UID: 7, Name: "root", Secret: "y"}, &m, gocast.ConvertOptions{
+		Tag:     "json",
+		Renames: map[string]string{"login": "name"},
+		Ignore:  []string{"secret"},
+	})
+	expected := map[string]any{"user_id": 7, "login": "root"}
+	if err != nil || !reflect.DeepEqual(m, expected) {
+		t.Errorf("Convert(struct, map) = %v, %v, expected %v", m, err, expected)
+	}
+
+	type address struct {
+		City string `cast:"city"`
+	}
+	clash := struct {
+		Address address `cast:",inline"`
+		City    string  `cast:"city"`
+	}{Address: address{City: "inlined"}, City: "parent"}
+
+	m = nil
+	if err := gocast.Convert(clash, &m, gocast.ConvertOptions{}); err != nil || !reflect.DeepEqual(m, map[string]any{"city": "parent"}) {
+		t.Errorf("Convert(struct, map) with inline field before parent field = %v, %v", m, err)
+	}
+}
+
+func TestConvertDepth(t *testing.T) {
+	type source struct {
+		Next *source `cast:"next"`
+	}
+	type target struct {
+		Next *target `cast:"next"`
+	}
+
+	src := &source{}
+	src.Next = src
+
+	var dst target
+	err := gocast.NewConverter(gocast.WithMaxDepth(10)).Convert(src, &dst, gocast.ConvertOptions{})
+
+	var errs gocast.FieldErrors
+	if !errors.As(err, &errs) || !gocast.IsLimitError(errs[0]) {
+		t.Errorf("Convert() of cyclic value error = %v, expected limit error", err)
+	}
+}
@@ -0,0 +1,480 @@
+package gocast
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Policy defines how special input values, such as nil and empty strings, are converted.
+type Policy int
+
+const (
+	// PolicyMissing reports the value as missing using the nil error (see IsNilError).
+	PolicyMissing Policy = iota
+	// PolicyZero converts the value to the zero value of the target type without error.
+	PolicyZero
+	// PolicyError reports the value as a casting error (see IsCastError).
+	PolicyError
+)
+
+// OverflowMode defines how out of range values are converted.
+type OverflowMode int
+
+const (
+	// OverflowError reports out of range values as overflow error (see IsOverflowError).
+	OverflowError OverflowMode = iota
+	// OverflowClamp converts out of range values to the nearest bound of the target type.
+	OverflowClamp
+)
+
+// RoundingMode defines how floats are converted to integers.
+type RoundingMode int
+
+const (
+	// RoundTruncate truncates the fraction (rounds toward zero).
+	RoundTruncate RoundingMode = iota
+	// RoundHalfUp rounds to the nearest integer, rounding half away from zero.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest integer, rounding half to even.
+	RoundHalfEven
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+	// RoundExact reports floats with fraction as casting error.
+	RoundExact
+)
+
+// ParseMode enables special formats of numeric strings in float conversions.
+// Modes are combined using bitwise or, e.g. ParsePercent | ParseFraction.
+type ParseMode int
+
+const (
+	// ParsePercent parses percentages as fractions, e.g. "15%" to 0.15.
+	ParsePercent ParseMode = 1 << iota
+	// ParsePercentRaw parses percentages as raw numbers, e.g. "15%" to 15.
+	ParsePercentRaw
+	// ParseFraction parses simple fractions, e.g. "3/4" to 0.75.
+	ParseFraction
+	// ParseRatio parses ratios as the quotient of the terms, e.g. "1:4" to 0.25.
+	ParseRatio
+)
+
+// NumberFormat defines the format of numeric strings.
+type NumberFormat struct {
+	// Base is the integer base, zero means the base is implied by the
+	// string prefix (0b, 0o, 0x) and defaults to 10.
+	Base int
+	// ThousandsSeparator is removed from numeric strings, e.g. "," for "1,234.5".
+	ThousandsSeparator string
+	// DecimalSeparator is the decimal point, defaults to ".", e.g. "," for "1.234,5".
+	DecimalSeparator string
+}
+
+type options struct {
+	trueValues     []string
+	falseValues    []string
+	numberFormat   NumberFormat
+	overflow       OverflowMode
+	rounding       RoundingMode
+	nilPolicy      Policy
+	emptyPolicy    Policy
+	emptyStrings   bool
+	timeLayouts    []string
+	sliceDelimiter string
+	registry       *Registry
+	maxSize        int64
+	maxDepth       int
+	multipliers    map[string]*big.Rat
+	bytesFormat    bool
+	bytesUnits     ByteUnits
+	bytesPrecision int
+	parseModes     ParseMode
+	percentFormat  bool
+	percentDigits  int
+}
+
+// Option configures a Converter.
+type Option func(*options)
+
+// WithBoolValues sets the bool vocabulary used for string conversion, e.g. "yes" and "no".
+// Values are matched case-insensitively. By default strconv.ParseBool values are accepted.
+func WithBoolValues(trueValues, falseValues []string) Option {
+	return func(o *options) {
+		o.trueValues = append([]string{}, trueValues...)
+		o.falseValues = append([]string{}, falseValues...)
+	}
+}
+
+// WithNumberFormat sets the format of numeric strings.
+func WithNumberFormat(format NumberFormat) Option {
+	return func(o *options) {
+		o.numberFormat = format
+	}
+}
+
+// WithOverflow sets the conversion mode of out of range values. Default is OverflowError.
+func WithOverflow(mode OverflowMode) Option {
+	return func(o *options) {
+		o.overflow = mode
+	}
+}
+
+// WithRounding sets the rounding mode of float to integer conversions. Default is RoundTruncate.
+func WithRounding(mode RoundingMode) Option {
+	return func(o *options) {
+		o.rounding = mode
+	}
+}
+
+// WithNilPolicy sets the conversion policy of nil values. Default is PolicyMissing.
+func WithNilPolicy(policy Policy) Option {
+	return func(o *options) {
+		o.nilPolicy = policy
+	}
+}
+
+// WithEmptyPolicy sets the conversion policy of empty and whitespace-only strings.
+// By default empty strings are parsed as any other string, so converting them
+// to non-string types fails with a casting error.
+func WithEmptyPolicy(policy Policy) Option {
+	return func(o *options) {
+		o.emptyPolicy = policy
+		o.emptyStrings = true
+	}
+}
+
+// WithTimeLayouts sets the layouts used for parsing time strings, in order of preference.
+// By default RFC3339, DateTime, DateOnly and RFC1123 layouts are used.
+func WithTimeLayouts(layouts ...string) Option {
+	return func(o *options) {
+		o.timeLayouts = append([]string{}, layouts...)
+	}
+}
+
+// WithSliceDelimiter enables splitting string values by the delimiter in slice conversions,
+// e.g. "1,2,3" to []int{1, 2, 3}. Items are trimmed.
+func WithSliceDelimiter(delimiter string) Option {
+	return func(o *options) {
+		o.sliceDelimiter = delimiter
+	}
+}
+
+// WithRegistry sets the registry of custom converters used by Decode.
+func WithRegistry(registry *Registry) Option {
+	return func(o *options) {
+		o.registry = registry
+	}
+}
+
+// WithMaxSize limits the size of parsed documents (e.g. FromJSON) in bytes. Default is unlimited.
+func WithMaxSize(bytes int64) Option {
+	return func(o *options) {
+		o.maxSize = bytes
+	}
+}
+
+// WithMaxDepth limits the nesting depth of parsed documents (e.g. FromJSON). Default is 1000.
+func WithMaxDepth(depth int) Option {
+	return func(o *options) {
+		o.maxDepth = depth
+	}
+}
+
+// defaultMultipliers are the suffix multipliers enabled by WithSuffixMultipliers(nil).
+var defaultMultipliers = map[string]float64{
+	"k": 1e3, "K": 1e3, "M": 1e6, "G": 1e9, "T": 1e12, "P": 1e15,
+	"Ki": 1 << 10, "Mi": 1 << 20, "Gi": 1 << 30, "Ti": 1 << 40, "Pi": 1 << 50,
+}
+
+// WithSuffixMultipliers enables unit suffixes in numeric strings, e.g. "5k" to 5000 or "1.5M" to 1500000.
+// Suffixes are matched case-sensitively and may be separated by spaces. A nil map enables
+// the SI (k, K, M, G, T, P) and IEC (Ki, Mi, Gi, Ti, Pi) suffixes.
+// Results are range checked and rounded as other conversions.
+func WithSuffixMultipliers(multipliers map[string]float64) Option {
+	if multipliers == nil {
+		multipliers = defaultMultipliers
+	}
+
+	rats := make(map[string]*big.Rat, len(multipliers))
+	for suffix, multiplier := range multipliers {
+		if r, ok := new(big.Rat).SetString(strconv.FormatFloat(multiplier, 'g', -1, 64)); ok && suffix != "" {
+			rats[suffix] = r
+		}
+	}
+
+	return func(o *options) {
+		o.multipliers = rats
+	}
+}
+
+// WithBytesFormat formats integers as human-readable byte sizes in string conversions,
+// e.g. 1536 to "1.5 KiB" (BytesIEC) or "1.54 kB" (BytesSI), with at most precision decimals.
+func WithBytesFormat(units ByteUnits, precision int) Option {
+	return func(o *options) {
+		o.bytesFormat = true
+		o.bytesUnits = units
+		o.bytesPrecision = precision
+	}
+}
+
+// WithParseModes enables percentages, fractions and ratios in float conversions (e.g. ToFloat64 and ToRat).
+func WithParseModes(modes ParseMode) Option {
+	return func(o *options) {
+		o.parseModes = modes
+	}
+}
+
+// WithPercentFormat formats floats and rationals as percentages in string conversions,
+// e.g. 0.155 to "15.5%", with at most precision decimals.
+func WithPercentFormat(precision int) Option {
+	return func(o *options) {
+		o.percentFormat = true
+		o.percentDigits = precision
+	}
+}
+
+// Converter converts values using its options.
+// Converters are immutable and safe for concurrent use.
+// Package level functions use a converter with default options.
+type Converter struct {
+	options options
+	// exact rejects rounding and overflow of numbers, created on first use.
+	exact     *Converter
+	exactOnce sync.Once
+}
+
+var defaultConverter = NewConverter()
+
+// NewConverter creates a new Converter with the provided options.
+func NewConverter(opts ...Option) *Converter {
+	c := &Converter{}
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+	return c
+}
+
+// With creates a new Converter with the options of c and the provided options.
+func (c *Converter) With(opts ...Option) *Converter {
+	res := &Converter{options: c.options}
+	for _, opt := range opts {
+		opt(&res.options)
+	}
+	return res
+}
+
+// NewCaster creates a new Caster for the value that converts using the converter options.
+func (c *Converter) NewCaster(v any) Caster {
+	return casterDriver{
+		data: v,
+		conv: c,
+	}
+}
+
+// Decode decodes the input value into the value pointed by out using the converter options.
+// See Decode function for details.
+func (c *Converter) Decode(input any, out any) error {
+	return decode(c, input, out)
+}
+
+// ToSlice casts an interface{} to a []interface{} type.
+func (c *Converter) ToSlice(value any) ([]any, error) {
+	return toSlice(c, value)
+}
+
+// exactNumbers returns the converter with RoundExact rounding and OverflowError overflow,
+// used to parse the numbers of enums and flags.
+func (c *Converter) exactNumbers() *Converter {
+	if c.options.rounding == RoundExact && c.options.overflow == OverflowError {
+		return c
+	}
+
+	c.exactOnce.Do(func() {
+		c.exact = c.With(WithRounding(RoundExact), WithOverflow(OverflowError))
+	})
+	return c.exact
+}
+
+// nilError returns the error of nil values based on the nil policy.
+func (c *Converter) nilError(t string) error {
+	return policyError(c.options.nilPolicy, t)
+}
+
+// isEmpty checks if the string is empty or whitespace-only and the empty policy is set.
+func (c *Converter) isEmpty(s string) bool {
+	return c.options.emptyStrings && strings.TrimSpace(s) == ""
+}
+
+// emptyError returns the error of empty strings based on the empty policy.
+func (c *Converter) emptyError(t string) error {
+	return policyError(c.options.emptyPolicy, t)
+}
+
+// policyError returns the error of the policy for the type name t.
+func policyError(policy Policy, t string) error {
+	switch policy {
+	case PolicyZero:
+		return nil
+	case PolicyError:
+		return typeError(t)
+	default:
+		return nilErr()
+	}
+}
+
+// parseBool parses a bool string using the bool vocabulary.
+func (c *Converter) parseBool(s string) (bool, error) {
+	if c.isEmpty(s) {
+		return false, c.emptyError("bool")
+	} else if c.options.trueValues == nil && c.options.falseValues == nil {
+		return parseBool(s)
+	}
+
+	s = strings.TrimSpace(s)
+	for _, v := range c.options.trueValues {
+		if strings.EqualFold(v, s) {
+			return true, nil
+		}
+	}
+
+	for _, v := range c.options.falseValues {
+		if strings.EqualFold(v, s) {
+			return false, nil
+		}
+	}
+	return false, typeError("bool")
+}
+
+// normalizeNumber removes the thousands separator and replaces the decimal separator with ".".
+func (c *Converter) normalizeNumber(s string) string {
+	format := c.options.numberFormat
+	if format.ThousandsSeparator != "" {
+		s = strings.ReplaceAll(s, format.ThousandsSeparator, "")
+	}
+
+	if format.DecimalSeparator != "" && format.DecimalSeparator != "." {
+		s = strings.Replace(s, format.DecimalSeparator, ".", 1)
+	}
+	return s
+}
+
+// parseMultiplied parses a number with a suffix multiplier, e.g. "1.5k".
+// It returns false if no multipliers are configured or the suffix is unknown.
+func (c *Converter) parseMultiplied(s string) (*big.Rat, bool) {
+	if len(c.options.multipliers) == 0 {
+		return nil, false
+	}
+
+	s = strings.TrimSpace(s)
+	match := ""
+	for suffix := range c.options.multipliers {
+		if len(suffix) > len(match) && strings.HasSuffix(s, suffix) {
+			match = suffix
+		}
+	}
+
+	if match == "" {
+		return nil, false
+	}
+
+	r, ok := parseDecimal(strings.TrimSpace(s[:len(s)-len(match)]))
+	if !ok {
+		return nil, false
+	}
+	return r.Mul(r, c.options.multipliers[match]), true
+}
+
+// parseModed parses percentages, fractions and ratios enabled by the parse modes.
+// It returns false if the string is not in an enabled format.
+func (c *Converter) parseModed(s string) (*big.Rat, bool) {
+	modes := c.options.parseModes
+	if modes == 0 {
+		return nil, false
+	}
+
+	s = strings.TrimSpace(s)
+	if number, ok := strings.CutSuffix(s, "%"); ok && modes&(ParsePercent|ParsePercentRaw) != 0 {
+		r, ok := parseDecimal(strings.TrimSpace(number))
+		if ok && modes&ParsePercentRaw == 0 {
+			r.Quo(r, big.NewRat(100, 1))
+		}
+		return r, ok
+	}
+
+	if a, b, ok := strings.Cut(s, "/"); ok && modes&ParseFraction != 0 {
+		return parseQuotient(a, b)
+	} else if a, b, ok := strings.Cut(s, ":"); ok && modes&ParseRatio != 0 {
+		return parseQuotient(a, b)
+	}
+	return nil, false
+}
+
+// round rounds the float to an integer value using the rounding mode.
+// It returns false if the float cannot be rounded using RoundExact mode.
+func (c *Converter) round(f float64) (float64, bool) {
+	switch c.options.rounding {
+	case RoundHalfUp:
+		return math.Round(f), true
+	case RoundHalfEven:
+		return math.RoundToEven(f), true
+	case RoundFloor:
+		return math.Floor(f), true
+	case RoundCeil:
+		return math.Ceil(f), true
+	case RoundExact:
+		return f, f == math.Trunc(f)
+	default:
+		return math.Trunc(f), true
+	}
+}
+
+// splitSlice splits the string by the slice delimiter.
+// It returns false if no delimiter is configured.
+func (c *Converter) splitSlice(s string) ([]string, bool) {
+	if c.options.sliceDelimiter == "" {
+		return nil, false
+	}
+	return splitList(s, c.options.sliceDelimiter), true
+}
+
+// Registry holds custom conversion functions by target type.
+// It is safe for concurrent use.
+type Registry struct {
+	mutex      sync.RWMutex
+	converters map[reflect.Type]func(value any) (any, error)
+}
+
+// NewRegistry creates a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		converters: make(map[reflect.Type]func(value any) (any, error)),
+	}
+}
+
+// RegisterConverter registers the conversion function of type T in the registry.
+// Decode uses the function for values of type T.
+func RegisterConverter[T any](registry *Registry, fn func(value any) (T, error)) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.converters[reflect.TypeOf((*T)(nil)).Elem()] = func(value any) (any, error) {
+		return fn(value)
+	}
+}
+
+// lookup returns the conversion function of the type.
+func (r *Registry) lookup(t reflect.Type) (func(value any) (any, error), bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	fn, ok := r.converters[t]
+	return fn, ok
+}
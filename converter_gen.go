@@ -0,0 +1,143 @@
+// Code generated by gocastgen. DO NOT EDIT.
+
+package gocast
+
+// ToBool casts an interface to a bool using the converter options.
+func (c *Converter) ToBool(value any) (bool, error) {
+	return toBool(c, value)
+}
+
+// ToInt casts an interface to an int using the converter options.
+func (c *Converter) ToInt(value any) (int, error) {
+	return toSigned[int](c, value)
+}
+
+// ToInt8 casts an interface to an int8 using the converter options.
+func (c *Converter) ToInt8(value any) (int8, error) {
+	return toSigned[int8](c, value)
+}
+
+// ToInt16 casts an interface to an int16 using the converter options.
+func (c *Converter) ToInt16(value any) (int16, error) {
+	return toSigned[int16](c, value)
+}
+
+// ToInt32 casts an interface to an int32 using the converter options.
+func (c *Converter) ToInt32(value any) (int32, error) {
+	return toSigned[int32](c, value)
+}
+
+// ToInt64 casts an interface to an int64 using the converter options.
+func (c *Converter) ToInt64(value any) (int64, error) {
+	return toSigned[int64](c, value)
+}
+
+// ToUint casts an interface to a uint using the converter options.
+func (c *Converter) ToUint(value any) (uint, error) {
+	return toUnsigned[uint](c, value)
+}
+
+// ToUint8 casts an interface to a uint8 using the converter options.
+func (c *Converter) ToUint8(value any) (uint8, error) {
+	return toUnsigned[uint8](c, value)
+}
+
+// ToUint16 casts an interface to a uint16 using the converter options.
+func (c *Converter) ToUint16(value any) (uint16, error) {
+	return toUnsigned[uint16](c, value)
+}
+
+// ToUint32 casts an interface to a uint32 using the converter options.
+func (c *Converter) ToUint32(value any) (uint32, error) {
+	return toUnsigned[uint32](c, value)
+}
+
+// ToUint64 casts an interface to a uint64 using the converter options.
+func (c *Converter) ToUint64(value any) (uint64, error) {
+	return toUnsigned[uint64](c, value)
+}
+
+// ToFloat32 casts an interface to a float32 using the converter options.
+func (c *Converter) ToFloat32(value any) (float32, error) {
+	return toFloat[float32](c, value)
+}
+
+// ToFloat64 casts an interface to a float64 using the converter options.
+func (c *Converter) ToFloat64(value any) (float64, error) {
+	return toFloat[float64](c, value)
+}
+
+// ToString casts an interface to a string using the converter options.
+func (c *Converter) ToString(value any) (string, error) {
+	return toString(c, value)
+}
+
+// ToBoolSlice casts an interface to a slice of bool using the converter options.
+func (c *Converter) ToBoolSlice(value any) ([]bool, error) {
+	return toBoolSlice(c, value)
+}
+
+// ToIntSlice casts an interface to a slice of int using the converter options.
+func (c *Converter) ToIntSlice(value any) ([]int, error) {
+	return toSignedSlice[int](c, value)
+}
+
+// ToInt8Slice casts an interface to a slice of int8 using the converter options.
+func (c *Converter) ToInt8Slice(value any) ([]int8, error) {
+	return toSignedSlice[int8](c, value)
+}
+
+// ToInt16Slice casts an interface to a slice of int16 using the converter options.
+func (c *Converter) ToInt16Slice(value any) ([]int16, error) {
+	return toSignedSlice[int16](c, value)
+}
+
+// ToInt32Slice casts an interface to a slice of int32 using the converter options.
+func (c *Converter) ToInt32Slice(value any) ([]int32, error) {
+	return toSignedSlice[int32](c, value)
+}
+
+// ToInt64Slice casts an interface to a slice of int64 using the converter options.
+func (c *Converter) ToInt64Slice(value any) ([]int64, error) {
+	return toSignedSlice[int64](c, value)
+}
+
+// ToUintSlice casts an interface to a slice of uint using the converter options.
+func (c *Converter) ToUintSlice(value any) ([]uint, error) {
+	return toUnsignedSlice[uint](c, value)
+}
+
+// ToUint8Slice casts an interface to a slice of uint8 using the converter options.
+func (c *Converter) ToUint8Slice(value any) ([]uint8, error) {
+	return toUnsignedSlice[uint8](c, value)
+}
+
+// ToUint16Slice casts an interface to a slice of uint16 using the converter options.
+func (c *Converter) ToUint16Slice(value any) ([]uint16, error) {
+	return toUnsignedSlice[uint16](c, value)
+}
+
+// ToUint32Slice casts an interface to a slice of uint32 using the converter options.
+func (c *Converter) ToUint32Slice(value any) ([]uint32, error) {
+	return toUnsignedSlice[uint32](c, value)
+}
+
+// ToUint64Slice casts an interface to a slice of uint64 using the converter options.
+func (c *Converter) ToUint64Slice(value any) ([]uint64, error) {
+	return toUnsignedSlice[uint64](c, value)
+}
+
+// ToFloat32Slice casts an interface to a slice of float32 using the converter options.
+func (c *Converter) ToFloat32Slice(value any) ([]float32, error) {
+	return toFloatSlice[float32](c, value)
+}
+
+// ToFloat64Slice casts an interface to a slice of float64 using the converter options.
+func (c *Converter) ToFloat64Slice(value any) ([]float64, error) {
+	return toFloatSlice[float64](c, value)
+}
+
+// ToStringSlice casts an interface to a slice of string using the converter options.
+func (c *Converter) ToStringSlice(value any) ([]string, error) {
+	return toStringSlice(c, value)
+}
@@ -0,0 +1,294 @@
+package gocast_test
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestConverterOptions(t *testing.T) {
+	yesNo := gocast.NewConverter(gocast.WithBoolValues([]string{"yes", "on"}, []string{"no", "off"}))
+	if v, err := yesNo.ToBool("YES"); err != nil || !v {
+		t.Errorf("ToBool(YES) = %v, %v", v, err)
+	}
+	if _, err := yesNo.ToBool("true"); !gocast.IsCastError(err) {
+		t.Errorf("ToBool(true) error = %v, expected cast error", err)
+	}
+
+	european := gocast.NewConverter(gocast.WithNumberFormat(gocast.NumberFormat{
+		ThousandsSeparator: ".",
+		DecimalSeparator:   ",",
+	}))
+	if v, err := european.ToFloat64("1.234,5"); err != nil || v != 1234.5 {
+		t.Errorf("ToFloat64(1.234,5) = %v, %v", v, err)
+	}
+
+	hex := gocast.NewConverter(gocast.WithNumberFormat(gocast.NumberFormat{Base: 16}))
+	if v, err := hex.ToInt("ff"); err != nil || v != 255 {
+		t.Errorf("ToInt(ff) = %v, %v", v, err)
+	}
+
+	clamp := gocast.NewConverter(gocast.WithOverflow(gocast.OverflowClamp))
+	if v, err := clamp.ToInt8(300); err != nil || v != 127 {
+		t.Errorf("ToInt8(300) = %v, %v", v, err)
+	}
+	if v, err := clamp.ToUint(-5); err != nil || v != 0 {
+		t.Errorf("ToUint(-5) = %v, %v", v, err)
+	}
+	if v, err := clamp.ToInt16("-99999"); err != nil || v != -32768 {
+		t.Errorf("ToInt16(-99999) = %v, %v", v, err)
+	}
+
+	rounding := []struct {
+		mode     gocast.RoundingMode
+		input    float64
+		expected int
+	}{
+		{gocast.RoundTruncate, 2.7, 2},
+		{gocast.RoundHalfUp, 2.5, 3},
+		{gocast.RoundHalfUp, -2.5, -3},
+		{gocast.RoundHalfEven, 2.5, 2},
+		{gocast.RoundFloor, -2.1, -3},
+		{gocast.RoundCeil, 2.1, 3},
+		{gocast.RoundExact, 2.0, 2},
+	}
+	for _, test := range rounding {
+		c := gocast.NewConverter(gocast.WithRounding(test.mode))
+		if v, err := c.ToInt(test.input); err != nil || v != test.expected {
+			t.Errorf("ToInt(%v) with mode %v = %v, %v", test.input, test.mode, v, err)
+		}
+	}
+	if _, err := gocast.NewConverter(gocast.WithRounding(gocast.RoundExact)).ToInt("2.5"); !gocast.IsCastError(err) {
+		t.Errorf("ToInt(2.5) with RoundExact error = %v, expected cast error", err)
+	}
+
+	zero := gocast.NewConverter(gocast.WithNilPolicy(gocast.PolicyZero))
+	if v, err := zero.ToInt(nil); err != nil || v != 0 {
+		t.Errorf("ToInt(nil) with PolicyZero = %v, %v", v, err)
+	}
+	strict := gocast.NewConverter(gocast.WithNilPolicy(gocast.PolicyError))
+	if _, err := strict.ToString(nil); !gocast.IsCastError(err) {
+		t.Errorf("ToString(nil) with PolicyError error = %v, expected cast error", err)
+	}
+
+	delimited := gocast.NewConverter(gocast.WithSliceDelimiter(","))
+	if v, err := delimited.ToIntSlice("1, 2,3"); err != nil || !reflect.DeepEqual(v, []int{1, 2, 3}) {
+		t.Errorf("ToIntSlice(1, 2,3) = %v, %v", v, err)
+	}
+	if _, err := gocast.ToSignedSlice[int]("1,2,3"); err == nil {
+		t.Errorf("ToIntSlice(1,2,3) without delimiter expected error")
+	}
+}
+
+func TestConverterWith(t *testing.T) {
+	base := gocast.NewConverter(gocast.WithOverflow(gocast.OverflowClamp))
+	derived := base.With(gocast.WithRounding(gocast.RoundHalfUp))
+
+	if v, err := derived.ToInt8(1000.6); err != nil || v != 127 {
+		t.Errorf("ToInt8(1000.6) = %v, %v", v, err)
+	}
+	if v, _ := derived.ToInt("2.6"); v != 3 {
+		t.Errorf("ToInt(2.6) = %v, expected 3", v)
+	}
+	if v, _ := base.ToInt("2.6"); v != 2 {
+		t.Errorf("base ToInt(2.6) = %v, expected 2", v)
+	}
+}
+
+func TestConverterCaster(t *testing.T) {
+	c := gocast.NewConverter(gocast.WithBoolValues([]string{"yes"}, []string{"no"}))
+	caster := c.NewCaster(map[string]any{"flags": map[string]any{"debug": "yes"}})
+
+	if v, err := caster.Get("flags.debug").Bool(); err != nil || !v {
+		t.Errorf("Get(flags.debug).Bool() = %v, %v", v, err)
+	}
+
+	if _, err := gocast.NewCaster("yes").Bool(); err == nil {
+		t.Errorf("NewCaster(yes).Bool() expected error with default converter")
+	}
+}
+
+func TestConverterTime(t *testing.T) {
+	expected := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	for _, input := range []any{"2024-05-01T10:30:00Z", "2024-05-01 10:30:00", expected.Unix()} {
+		if v, err := gocast.ToTime(input); err != nil || !v.Equal(expected) {
+			t.Errorf("ToTime(%v) = %v, %v", input, v, err)
+		}
+	}
+
+	c := gocast.NewConverter(gocast.WithTimeLayouts("02/01/2006"))
+	if v, err := c.ToTime("01/05/2024"); err != nil || !v.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ToTime(01/05/2024) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToDuration("1m30s"); err != nil || v != 90*time.Second {
+		t.Errorf("ToDuration(1m30s) = %v, %v", v, err)
+	}
+	if _, err := gocast.ToTime("invalid"); !gocast.IsCastError(err) {
+		t.Errorf("ToTime(invalid) error = %v, expected cast error", err)
+	}
+}
+
+type point struct{ X, Y int }
+
+func TestConverterDecode(t *testing.T) {
+	registry := gocast.NewRegistry()
+	gocast.RegisterConverter(registry, func(value any) (point, error) {
+		x, y, ok := strings.Cut(gocast.NewCaster(value).StringSafe(""), ":")
+		if !ok {
+			return point{}, errors.New("invalid point")
+		}
+		return point{gocast.NewCaster(x).IntSafe(0), gocast.NewCaster(y).IntSafe(0)}, nil
+	})
+
+	type Config struct {
+		Origin  point         `cast:"origin"`
+		Timeout time.Duration `cast:"timeout"`
+		Level   int8          `cast:"level"`
+		Tags    []string      `cast:"tags"`
+	}
+
+	c := gocast.NewConverter(
+		gocast.WithRegistry(registry),
+		gocast.WithOverflow(gocast.OverflowClamp),
+		gocast.WithSliceDelimiter(","),
+	)
+
+	var config Config
+	err := c.Decode(map[string]any{
+		"origin":  "3:4",
+		"timeout": "5s",
+		"level":   1000,
+		"tags":    "a,b",
+	}, &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Config{point{3, 4}, 5 * time.Second, 127, []string{"a", "b"}}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("Decode() = %+v, expected %+v", config, expected)
+	}
+
+	var numbers []int
+	if err := c.Decode([]any{1, "2", 3}, &numbers); err != nil || !reflect.DeepEqual(numbers, []int{1, 2, 3}) {
+		t.Errorf("Decode([]any) with delimiter = %v, %v", numbers, err)
+	}
+}
+
+func TestConverterConcurrency(t *testing.T) {
+	c := gocast.NewConverter(gocast.WithOverflow(gocast.OverflowClamp))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if v, _ := c.ToUint8(j * 10); j*10 > 255 && v != 255 {
+					t.Errorf("ToUint8(%d) = %v", j*10, v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestEmptyPolicy(t *testing.T) {
+	if _, err := gocast.ToSigned[int](""); !gocast.IsCastError(err) {
+		t.Errorf("ToSigned(\"\") error = %v, expected cast error by default", err)
+	}
+
+	zero := gocast.NewConverter(gocast.WithEmptyPolicy(gocast.PolicyZero))
+	if v, err := zero.ToInt("  "); err != nil || v != 0 {
+		t.Errorf("ToInt(blank) with PolicyZero = %v, %v", v, err)
+	}
+	if v, err := zero.ToFloat64Slice([]string{"1.5", ""}); err != nil || !reflect.DeepEqual(v, []float64{1.5, 0}) {
+		t.Errorf("ToFloat64Slice with PolicyZero = %v, %v", v, err)
+	}
+
+	missing := gocast.NewConverter(gocast.WithEmptyPolicy(gocast.PolicyMissing))
+	if _, err := missing.ToBool(""); !gocast.IsNilError(err) {
+		t.Errorf("ToBool(\"\") with PolicyMissing error = %v, expected nil error", err)
+	}
+	if _, err := missing.ToString(" "); !gocast.IsNilError(err) {
+		t.Errorf("ToString(blank) with PolicyMissing error = %v, expected nil error", err)
+	}
+
+	strict := gocast.NewConverter(gocast.WithEmptyPolicy(gocast.PolicyError))
+	if _, err := strict.ToString(""); !gocast.IsCastError(err) {
+		t.Errorf("ToString(\"\") with PolicyError error = %v, expected cast error", err)
+	}
+
+	caster := gocast.NewCaster("").With(gocast.WithEmptyPolicy(gocast.PolicyZero), gocast.WithNilPolicy(gocast.PolicyZero))
+	if v, err := caster.Int(); err != nil || v != 0 {
+		t.Errorf("Caster.With().Int() = %v, %v", v, err)
+	}
+	if v, err := caster.Get("missing").Int(); err != nil || v != 0 {
+		t.Errorf("Caster.With().Get(missing).Int() = %v, %v", v, err)
+	}
+}
+
+func TestConverterGenerics(t *testing.T) {
+	missing := gocast.NewConverter(gocast.WithEmptyPolicy(gocast.PolicyMissing))
+	if _, err := gocast.ToTextWith[*netip.Addr](missing, ""); !gocast.IsNilError(err) {
+		t.Errorf("ToTextWith(\"\") with PolicyMissing error = %v, expected nil error", err)
+	}
+	if v, err := gocast.ToOptionalWith[int](missing, " "); err != nil || v.IsSet() {
+		t.Errorf("ToOptionalWith(blank) with PolicyMissing = %v, %v, expected unset", v, err)
+	}
+	if v, err := gocast.ToPtrWith[int](missing, ""); err != nil || v != nil {
+		t.Errorf("ToPtrWith(\"\") with PolicyMissing = %v, %v, expected nil", v, err)
+	}
+	if _, err := gocast.ToEnumWith[enumStatus](missing, ""); !gocast.IsNilError(err) {
+		t.Errorf("ToEnumWith(\"\") with PolicyMissing error = %v, expected nil error", err)
+	}
+	if _, err := gocast.ToFlagsWith[flagPerm](missing, ""); !gocast.IsNilError(err) {
+		t.Errorf("ToFlagsWith(\"\") with PolicyMissing error = %v, expected nil error", err)
+	}
+
+	yesNo := gocast.NewConverter(gocast.WithBoolValues([]string{"yes"}, []string{"no"}))
+	if v, err := gocast.ToPtrWith[bool](yesNo, "yes"); err != nil || v == nil || !*v {
+		t.Errorf("ToPtrWith[bool](yes) = %v, %v", v, err)
+	}
+}
+
+func TestCasterEmptyAndZero(t *testing.T) {
+	var nilPtr *int
+	zero := 0
+	tests := []struct {
+		input  any
+		empty  bool
+		isZero bool
+	}{
+		{nil, true, true},
+		{nilPtr, true, true},
+		{"", true, true},
+		{" \t", true, true},
+		{"x", false, false},
+		{[]int{}, true, true},
+		{map[string]any{}, true, true},
+		{[]int{1}, false, false},
+		{0, false, true},
+		{&zero, false, true},
+		{false, false, true},
+		{1.5, false, false},
+		{time.Time{}, false, true},
+	}
+
+	for _, test := range tests {
+		caster := gocast.NewCaster(test.input)
+		if caster.IsEmpty() != test.empty {
+			t.Errorf("IsEmpty(%#v) = %v, expected %v", test.input, caster.IsEmpty(), test.empty)
+		}
+		if caster.IsZero() != test.isZero {
+			t.Errorf("IsZero(%#v) = %v, expected %v", test.input, caster.IsZero(), test.isZero)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package gocast
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// CSVColumn defines the target type and conversion options of a CSV column.
+type CSVColumn struct {
+	// Name is the header name of the column.
+	Name string
+	// Type is the target type of the cells, nil keeps the cells as strings.
+	Type reflect.Type
+	// Options configure the conversion of the cells, e.g. WithTimeLayouts,
+	// WithNumberFormat or WithNilPolicy for empty cells.
+	Options []Option
+}
+
+// CSVColumnOf returns a column definition converting the cells to T.
+func CSVColumnOf[T any](name string, opts ...Option) CSVColumn {
+	return CSVColumn{Name: name, Type: reflect.TypeFor[T](), Options: opts}
+}
+
+// CSVError describes an error of a CSV cell.
+type CSVError struct {
+	// Line is the 1-based line of the cell.
+	Line int
+	// Column is the 1-based index of the cell in the record.
+	Column int
+	// Name is the header name of the column.
+	Name string
+	// Err is the underlying conversion error.
+	Err error
+}
+
+func (e *CSVError) Error() string {
+	return fmt.Sprintf("line %d, column %d (%s): %v", e.Line, e.Column, e.Name, e.Err)
+}
+
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+// CSVReader reads CSV records as rows of Casters keyed by the header names.
+// The first record is the header, the number of fields of the records must match the header.
+type CSVReader struct {
+	reader  *csv.Reader
+	conv    *Converter
+	header  []string
+	columns map[string]csvColumn
+	fields  map[reflect.Type][]csvField
+}
+
+// csvColumn is a schema column with its converter.
+type csvColumn struct {
+	typ  reflect.Type
+	conv *Converter
+}
+
+// csvField is a struct field bound to a column index.
+type csvField struct {
+	index  []int
+	typ    reflect.Type
+	column int
+}
+
+// NewCSVReader creates a new CSVReader of the reader.
+// Options configure the conversions of the cells.
+func NewCSVReader(r io.Reader, opts ...Option) *CSVReader {
+	return NewConverter(opts...).NewCSVReader(r)
+}
+
+// NewCSVReader creates a new CSVReader of the reader using the converter options.
+func (c *Converter) NewCSVReader(r io.Reader) *CSVReader {
+	return &CSVReader{
+		reader:  csv.NewReader(r),
+		conv:    c,
+		columns: make(map[string]csvColumn),
+		fields:  make(map[reflect.Type][]csvField),
+	}
+}
+
+// Reader returns the underlying csv.Reader to configure the format,
+// e.g. Comma or Comment, before reading.
+func (r *CSVReader) Reader() *csv.Reader {
+	return r.reader
+}
+
+// SetSchema sets the column definitions. Cells of the columns are converted to
+// the column type, empty cells are nil values converted using the nil policy
+// of the column options. Other columns are kept as strings.
+func (r *CSVReader) SetSchema(columns ...CSVColumn) {
+	for _, column := range columns {
+		r.columns[column.Name] = csvColumn{
+			typ:  column.Type,
+			conv: r.conv.With(column.Options...),
+		}
+	}
+}
+
+// Header returns the header names, reading the header record if needed.
+func (r *CSVReader) Header() ([]string, error) {
+	if r.header != nil {
+		return r.header, nil
+	}
+
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]string, len(record))
+	names := make(map[string]bool, len(record))
+	for i, name := range record {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+
+		name = strings.TrimSpace(name)
+		if names[name] {
+			line, _ := r.reader.FieldPos(i)
+			return nil, &CSVError{Line: line, Column: i + 1, Name: name, Err: errors.New("duplicate column")}
+		}
+		names[name] = true
+		header[i] = name
+	}
+	r.header = header
+	return header, nil
+}
+
+// Read reads the next row. It returns io.EOF when there are no more rows.
+func (r *CSVReader) Read() (map[string]Caster, error) {
+	record, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	row := make(map[string]Caster, len(record))
+	for i, cell := range record {
+		value, conv, err := r.cell(i, cell)
+		if err != nil {
+			return nil, err
+		}
+		row[r.header[i]] = conv.NewCaster(value)
+	}
+	return row, nil
+}
+
+// Rows returns an iterator of the remaining rows. Iteration stops after the first error.
+func (r *CSVReader) Rows() iter.Seq2[map[string]Caster, error] {
+	return func(yield func(map[string]Caster, error) bool) {
+		for {
+			row, err := r.Read()
+			if err == io.EOF || !yield(row, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Decode reads the next row into the struct pointed by out. Fields are bound
+// to columns by the `csv:"name"` tag or the field name (case-insensitive),
+// fields tagged `csv:"-"` are ignored. Empty cells leave the fields untouched
+// unless the nil policy of the column fails. It returns io.EOF when there are no more rows.
+func (r *CSVReader) Decode(out any) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode target must be a non-nil struct pointer")
+	}
+
+	record, err := r.next()
+	if err != nil {
+		return err
+	}
+
+	target := rv.Elem()
+	for _, field := range r.structFields(target.Type()) {
+		value, conv, err := r.cell(field.column, record[field.column])
+		if s, ok := value.(string); ok && err == nil && strings.TrimSpace(s) == "" {
+			value, err = r.emptyCell(field.column, field.typ, conv)
+		}
+
+		if err == nil {
+			err = decodeValue(conv, value, target.FieldByIndex(field.index), "")
+		}
+
+		if err != nil {
+			return r.cellError(field.column, err)
+		}
+	}
+	return nil
+}
+
+// DecodeCSV decodes all rows of the CSV reader into structs of type T.
+// See CSVReader.Decode for the field binding.
+func DecodeCSV[T any](r io.Reader, opts ...Option) ([]T, error) {
+	res := make([]T, 0)
+	for item, err := range DecodeCSVRows[T](NewCSVReader(r, opts...)) {
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// DecodeCSVRows returns an iterator decoding the remaining rows of the reader into structs of type T.
+// Iteration stops after the first error.
+func DecodeCSVRows[T any](r *CSVReader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			var item T
+			err := r.Decode(&item)
+			if err == io.EOF || !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// next reads the next record, reading the header first if needed.
+func (r *CSVReader) next() ([]string, error) {
+	if _, err := r.Header(); err != nil {
+		return nil, err
+	}
+	return r.reader.Read()
+}
+
+// cell converts the cell of the column index using the schema.
+// Cells of columns without type are returned as strings.
+func (r *CSVReader) cell(i int, cell string) (any, *Converter, error) {
+	column, ok := r.columns[r.header[i]]
+	if !ok {
+		return cell, r.conv, nil
+	} else if column.typ == nil {
+		return cell, column.conv, nil
+	}
+
+	if strings.TrimSpace(cell) == "" {
+		value, err := r.emptyCell(i, column.typ, column.conv)
+		return value, column.conv, err
+	}
+
+	value := reflect.New(column.typ).Elem()
+	if err := decodeValue(column.conv, cell, value, ""); err != nil {
+		return nil, nil, r.cellError(i, err)
+	}
+	return value.Interface(), column.conv, nil
+}
+
+// emptyCell converts the empty cell of the column index using the nil policy.
+func (r *CSVReader) emptyCell(i int, typ reflect.Type, conv *Converter) (any, error) {
+	switch conv.options.nilPolicy {
+	case PolicyZero:
+		return reflect.Zero(typ).Interface(), nil
+	case PolicyError:
+		return nil, r.cellError(i, typeError(typ.String()))
+	default:
+		return nil, nil
+	}
+}
+
+// cellError wraps the error with the position of the cell in the last record.
+func (r *CSVReader) cellError(i int, err error) error {
+	var csvErr *CSVError
+	if errors.As(err, &csvErr) {
+		return err
+	}
+
+	line, _ := r.reader.FieldPos(i)
+	return &CSVError{Line: line, Column: i + 1, Name: r.header[i], Err: err}
+}
+
+// structFields returns the fields of the struct type bound to the header columns.
+func (r *CSVReader) structFields(typ reflect.Type) []csvField {
+	if fields, ok := r.fields[typ]; ok {
+		return fields
+	}
+
+	fields := make([]csvField, 0)
+	for _, field := range structFields(typ, "csv") {
+		column := slices.Index(r.header, field.name)
+		if column < 0 {
+			column = slices.IndexFunc(r.header, func(name string) bool { return strings.EqualFold(name, field.name) })
+		}
+
+		if column >= 0 {
+			fields = append(fields, csvField{index: field.index, typ: field.field.Type, column: column})
+		}
+	}
+	r.fields[typ] = fields
+	return fields
+}
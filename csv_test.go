@@ -0,0 +1,129 @@
+package gocast_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+const csvInput = "\ufeffid,name,price,created,active\n" +
+	"1,apple,\"1.234,5\",02/01/2024,yes\n" +
+	"2,banana,,03/01/2024,no\n"
+
+func TestCSVReader(t *testing.T) {
+	reader := gocast.NewCSVReader(strings.NewReader(csvInput), gocast.WithBoolValues([]string{"yes"}, []string{"no"}))
+	reader.SetSchema(
+		gocast.CSVColumnOf[int]("id"),
+		gocast.CSVColumnOf[float64]("price", gocast.WithNumberFormat(gocast.NumberFormat{ThousandsSeparator: ".", DecimalSeparator: ","})),
+		gocast.CSVColumnOf[time.Time]("created", gocast.WithTimeLayouts("02/01/2006")),
+	)
+
+	header, err := reader.Header()
+	if err != nil || strings.Join(header, ",") != "id,name,price,created,active" {
+		t.Fatalf("Header() = %v, %v", header, err)
+	}
+
+	var rows []map[string]gocast.Caster
+	for row, err := range reader.Rows() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("Rows() returned %d rows, expected 2", len(rows))
+	}
+
+	first := rows[0]
+	if v, ok := first["id"].Interface().(int); !ok || v != 1 {
+		t.Errorf("id = %#v, expected int 1", first["id"].Interface())
+	}
+	if v := first["price"].Float64Safe(0); v != 1234.5 {
+		t.Errorf("price = %v, expected 1234.5", v)
+	}
+	if v, ok := first["created"].Interface().(time.Time); !ok || !v.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("created = %v", first["created"].Interface())
+	}
+	if !first["active"].BoolSafe(false) || first["name"].StringSafe("") != "apple" {
+		t.Errorf("active = %v, name = %v", first["active"].Interface(), first["name"].Interface())
+	}
+
+	if !rows[1]["price"].IsNil() || rows[1]["active"].BoolSafe(true) {
+		t.Errorf("second row = %v, %v", rows[1]["price"].Interface(), rows[1]["active"].Interface())
+	}
+}
+
+func TestDecodeCSV(t *testing.T) {
+	type Product struct {
+		ID      int    `csv:"id"`
+		Name    string `csv:"name"`
+		Price   float64
+		Active  bool      `csv:"active"`
+		Created time.Time `csv:"created"`
+		Ignored string    `csv:"-"`
+	}
+
+	input := strings.ReplaceAll(csvInput, "\"1.234,5\"", "1234.5")
+	reader := gocast.NewCSVReader(strings.NewReader(input), gocast.WithBoolValues([]string{"yes"}, []string{"no"}))
+	reader.SetSchema(gocast.CSVColumnOf[time.Time]("created", gocast.WithTimeLayouts("02/01/2006")))
+
+	var products []Product
+	for product, err := range gocast.DecodeCSVRows[Product](reader) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		products = append(products, product)
+	}
+
+	if len(products) != 2 || products[0].ID != 1 || products[0].Name != "apple" || products[0].Price != 1234.5 ||
+		!products[0].Active || products[0].Created.Day() != 2 || products[1].Price != 0 || products[1].Active {
+		t.Errorf("DecodeCSVRows() = %+v", products)
+	}
+
+	type Row struct {
+		ID    int `csv:"id"`
+		Value int `csv:"value"`
+	}
+
+	_, err := gocast.DecodeCSV[Row](strings.NewReader("id,value\n1,2\n2,abc\n"))
+	var csvErr *gocast.CSVError
+	if !errors.As(err, &csvErr) || csvErr.Line != 3 || csvErr.Column != 2 || csvErr.Name != "value" || !gocast.IsCastError(err) {
+		t.Errorf("DecodeCSV() error = %v, expected cast error at line 3, column 2", err)
+	}
+
+	rows, err := gocast.DecodeCSV[Row](strings.NewReader("id,value\n"))
+	if err != nil || len(rows) != 0 {
+		t.Errorf("DecodeCSV() of empty file = %v, %v", rows, err)
+	}
+}
+
+func TestCSVReaderErrors(t *testing.T) {
+	reader := gocast.NewCSVReader(strings.NewReader("a,b\n1,2,3\n"))
+	if _, err := reader.Read(); err == nil {
+		t.Errorf("Read() with extra fields expected error")
+	}
+
+	reader = gocast.NewCSVReader(strings.NewReader("a,a\n"))
+	if _, err := reader.Header(); err == nil {
+		t.Errorf("Header() with duplicate columns expected error")
+	}
+
+	reader = gocast.NewCSVReader(strings.NewReader("a\n\n"))
+	reader.SetSchema(gocast.CSVColumnOf[int]("a", gocast.WithNilPolicy(gocast.PolicyZero)))
+	if row, err := reader.Read(); err != io.EOF {
+		t.Errorf("Read() of blank line = %v, %v, expected EOF", row, err)
+	}
+
+	reader = gocast.NewCSVReader(strings.NewReader("a;b\n;x\n"))
+	reader.Reader().Comma = ';'
+	reader.SetSchema(gocast.CSVColumnOf[int]("a", gocast.WithNilPolicy(gocast.PolicyError)))
+	var csvErr *gocast.CSVError
+	if _, err := reader.Read(); !errors.As(err, &csvErr) || csvErr.Name != "a" {
+		t.Errorf("Read() of empty cell with error policy = %v", err)
+	}
+}
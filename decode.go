@@ -0,0 +1,418 @@
+package gocast
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Decode decodes the input value into the value pointed by out.
+// Maps are decoded into structs by the "cast" struct tag or the field name
+// (case-insensitive), slices, arrays and maps are decoded element by element,
+// and primary types are converted using the package converters.
+// Types implementing encoding.TextUnmarshaler are decoded from their text
+// representation. Types registered by RegisterEnum and RegisterFlags are decoded
+// from their names. Nil input values leave the target untouched.
+// Conversion errors are reported as *FieldError with the path of the field.
+//
+// Struct fields are validated after conversion by the rules of the `cast` tag options,
+// e.g. `cast:"port,required,min=1,max=65535"`. Rules are: required, min and max
+// (numbers, times, durations or lengths), len=2..10 (or exact length), oneof=a|b
+// and regex=pattern (must be the last option). Violations are reported as *ValidationError.
+func Decode(input any, out any) error {
+	return decode(defaultConverter, input, out)
+}
+
+// decode decodes the input value into the value pointed by out using the converter.
+func decode(c *Converter, input any, out any) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("decode target must be a non-nil pointer")
+	}
+	return decodeValue(c, input, rv.Elem(), "")
+}
+
+// decodeValue decodes the input into the settable out value.
+func decodeValue(c *Converter, input any, out reflect.Value, path string) error {
+	input = valueOf(input)
+	if input == nil {
+		return nil
+	}
+
+	// Custom converters
+	if fn, ok := c.options.registry.lookup(out.Type()); ok {
+		v, err := fn(input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		if rv := reflect.ValueOf(v); rv.IsValid() {
+			out.Set(rv)
+		} else {
+			out.SetZero()
+		}
+		return nil
+	}
+
+	// Registered enums
+	if enum, ok := enumOf(out.Type()); ok {
+		v, err := enum.parse(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		out.Set(reflect.ValueOf(v))
+		return nil
+	}
+
+	// Registered flags
+	if flags, ok := flagsOf(out.Type()); ok {
+		v, err := flags.parse(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		out.SetUint(v)
+		return nil
+	}
+
+	// Assign directly
+	in := reflect.ValueOf(input)
+	if in.Type().AssignableTo(out.Type()) {
+		out.Set(in)
+		return nil
+	}
+
+	// Allocate pointers
+	if out.Kind() == reflect.Ptr {
+		if out.IsNil() {
+			out.Set(reflect.New(out.Type().Elem()))
+		}
+		return decodeValue(c, input, out.Elem(), path)
+	}
+
+	// Optional values
+	if out.CanAddr() {
+		if setter, ok := out.Addr().Interface().(optionalSetter); ok {
+			return fieldError(path, setter.setOptional(c, input))
+		}
+	}
+
+	// Time and URL types
+	switch out.Type() {
+	case timeType:
+		v, err := toTime(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		out.Set(reflect.ValueOf(v))
+		return nil
+	case durationType:
+		v, err := toDuration(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		out.SetInt(int64(v))
+		return nil
+	case urlType:
+		v, err := toURL(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		out.Set(reflect.ValueOf(*v))
+		return nil
+	}
+
+	// Text unmarshaler
+	if out.CanAddr() && out.Addr().Type().Implements(textUnmarshalerType) {
+		text, err := toString(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+
+		err = out.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+		if err != nil {
+			return fieldError(path, typeError(out.Type().String()))
+		}
+		return nil
+	}
+
+	switch out.Kind() {
+	case reflect.Bool:
+		v, err := toBool(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		out.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := toSigned[int64](c, input)
+		if err != nil {
+			return fieldError(path, err)
+		} else if out.OverflowInt(v) {
+			if v, err = clampInt(c, out, v); err != nil {
+				return fieldError(path, err)
+			}
+		}
+		out.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := toUnsigned[uint64](c, input)
+		if err != nil {
+			return fieldError(path, err)
+		} else if out.OverflowUint(v) {
+			if v, err = clampUint(c, out, v); err != nil {
+				return fieldError(path, err)
+			}
+		}
+		out.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := toFloat[float64](c, input)
+		if err != nil {
+			return fieldError(path, err)
+		} else if out.OverflowFloat(v) {
+			if v, err = clampFloat(c, out, v); err != nil {
+				return fieldError(path, err)
+			}
+		}
+		out.SetFloat(v)
+	case reflect.String:
+		v, err := toString(c, input)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		out.SetString(v)
+	case reflect.Slice:
+		if s, ok := input.(string); ok && out.Type().Elem().Kind() == reflect.Uint8 {
+			out.SetBytes([]byte(s))
+			return nil
+		} else if ok {
+			if items, ok := c.splitSlice(s); ok {
+				return decodeSlice(c, reflect.ValueOf(items), out, path)
+			}
+		}
+		return decodeSlice(c, in, out, path)
+	case reflect.Array:
+		return decodeArray(c, in, out, path)
+	case reflect.Map:
+		return decodeMap(c, in, out, path)
+	case reflect.Struct:
+		return decodeStruct(c, in, out, path)
+	default:
+		return fieldError(path, typeError(out.Type().String()))
+	}
+	return nil
+}
+
+// clampInt returns the bound of the int value type nearest to v in OverflowClamp mode.
+func clampInt(c *Converter, out reflect.Value, v int64) (int64, error) {
+	if c.options.overflow != OverflowClamp {
+		return 0, overflowError(out.Type().String())
+	}
+
+	max := int64(1)<<(out.Type().Bits()-1) - 1
+	if v < 0 {
+		return -max - 1, nil
+	}
+	return max, nil
+}
+
+// clampUint returns the maximum of the uint value type in OverflowClamp mode.
+func clampUint(c *Converter, out reflect.Value, _ uint64) (uint64, error) {
+	if c.options.overflow != OverflowClamp {
+		return 0, overflowError(out.Type().String())
+	}
+	return uint64(1)<<out.Type().Bits() - 1, nil
+}
+
+// clampFloat returns the bound of the float value type nearest to v in OverflowClamp mode.
+func clampFloat(c *Converter, out reflect.Value, v float64) (float64, error) {
+	if c.options.overflow != OverflowClamp {
+		return 0, overflowError(out.Type().String())
+	}
+
+	max := math.MaxFloat64
+	if out.Type().Bits() == 32 {
+		max = math.MaxFloat32
+	}
+
+	if v < 0 {
+		return -max, nil
+	}
+	return max, nil
+}
+
+// decodeSlice decodes a slice, an array or a single value into a slice.
+func decodeSlice(c *Converter, in reflect.Value, out reflect.Value, path string) error {
+	if in.Kind() != reflect.Slice && in.Kind() != reflect.Array {
+		in = reflect.ValueOf([]any{in.Interface()})
+	}
+
+	res := reflect.MakeSlice(out.Type(), in.Len(), in.Len())
+	for i := 0; i < in.Len(); i++ {
+		err := decodeValue(c, in.Index(i).Interface(), res.Index(i), indexPath(path, i))
+		if err != nil {
+			return err
+		}
+	}
+	out.Set(res)
+	return nil
+}
+
+// decodeArray decodes a slice or an array with the same length into an array.
+func decodeArray(c *Converter, in reflect.Value, out reflect.Value, path string) error {
+	if (in.Kind() != reflect.Slice && in.Kind() != reflect.Array) || in.Len() != out.Len() {
+		return fieldError(path, typeError(out.Type().String()))
+	}
+
+	for i := 0; i < in.Len(); i++ {
+		err := decodeValue(c, in.Index(i).Interface(), out.Index(i), indexPath(path, i))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeMap decodes a map into a map.
+func decodeMap(c *Converter, in reflect.Value, out reflect.Value, path string) error {
+	if in.Kind() != reflect.Map {
+		return fieldError(path, typeError(out.Type().String()))
+	}
+
+	res := reflect.MakeMapWithSize(out.Type(), in.Len())
+	iter := in.MapRange()
+	for iter.Next() {
+		keyPath := joinPath(path, fmt.Sprint(iter.Key().Interface()))
+		key := reflect.New(out.Type().Key()).Elem()
+		if err := decodeValue(c, iter.Key().Interface(), key, keyPath); err != nil {
+			return err
+		}
+
+		value := reflect.New(out.Type().Elem()).Elem()
+		if err := decodeValue(c, iter.Value().Interface(), value, keyPath); err != nil {
+			return err
+		}
+		res.SetMapIndex(key, value)
+	}
+	out.Set(res)
+	return nil
+}
+
+// decodeStruct decodes a map with string keys into a struct.
+func decodeStruct(c *Converter, in reflect.Value, out reflect.Value, path string) error {
+	if in.Kind() != reflect.Map || in.Type().Key().Kind() != reflect.String {
+		return fieldError(path, typeError(out.Type().String()))
+	}
+
+	for _, field := range structFields(out.Type(), "cast") {
+		fieldPath := joinPath(path, field.name)
+		rules := parseRules(field.options)
+		input, ok := mapLookup(in, field.name)
+		if !ok || valueOf(input) == nil {
+			if hasRule(rules, "required") {
+				return fieldError(fieldPath, &ValidationError{Path: fieldPath, Rule: "required"})
+			}
+			continue
+		}
+
+		target := out.FieldByIndex(field.index)
+		if err := decodeValue(c, input, target, fieldPath); err != nil {
+			return err
+		}
+
+		if err := validateValue(rules, target, fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structField describes an exported struct field and its tag name and options.
+type structField struct {
+	name    string
+	index   []int
+	options []string
+	field   reflect.StructField
+}
+
+// structFields returns the exported fields of a struct type using the given tag for naming.
+// Untagged embedded structs are flattened into the parent.
+func structFields(typ reflect.Type, tag string) []structField {
+	var res []structField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, options := parseTag(field.Tag.Get(tag))
+		if name == "-" {
+			continue
+		}
+
+		// Flatten embedded structs
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for _, inner := range structFields(field.Type, tag) {
+				inner.index = append([]int{i}, inner.index...)
+				res = append(res, inner)
+			}
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		res = append(res, structField{
+			name:    name,
+			index:   []int{i},
+			options: options,
+			field:   field,
+		})
+	}
+	return res
+}
+
+// parseTag splits a struct tag into its name and options.
+func parseTag(tag string) (string, []string) {
+	parts := strings.Split(tag, ",")
+	return strings.TrimSpace(parts[0]), parts[1:]
+}
+
+// hasOption checks if the tag options contains the given option.
+func hasOption(options []string, option string) bool {
+	for _, o := range options {
+		if strings.TrimSpace(o) == option {
+			return true
+		}
+	}
+	return false
+}
+
+// mapLookup finds a key in a map with string keys.
+// It tries an exact match first and falls back to a case-insensitive match.
+func mapLookup(m reflect.Value, key string) (any, bool) {
+	k := reflect.ValueOf(key).Convert(m.Type().Key())
+	if v := m.MapIndex(k); v.IsValid() {
+		return v.Interface(), true
+	}
+
+	iter := m.MapRange()
+	for iter.Next() {
+		if strings.EqualFold(iter.Key().String(), key) {
+			return iter.Value().Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// joinPath appends a key to the path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// indexPath appends an index to the path.
+func indexPath(path string, index int) string {
+	return path + "[" + strconv.Itoa(index) + "]"
+}
@@ -0,0 +1,96 @@
+package gocast_test
+
+import (
+	"errors"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestToText(t *testing.T) {
+	addr, err := gocast.ToText[*netip.Addr]("127.0.0.1")
+	if err != nil || addr.String() != "127.0.0.1" {
+		t.Errorf("ToText[*netip.Addr]() = %v, %v", addr, err)
+	}
+
+	num, err := gocast.ToText[*big.Int](12345)
+	if err != nil || num.Int64() != 12345 {
+		t.Errorf("ToText[*big.Int]() = %v, %v", num, err)
+	}
+
+	if _, err := gocast.ToText[*netip.Addr]("invalid"); !gocast.IsCastError(err) {
+		t.Errorf("ToText[*netip.Addr](invalid) error = %v, expected cast error", err)
+	}
+}
+
+func TestTextMarshalerSource(t *testing.T) {
+	n := big.NewInt(42)
+	if v, err := gocast.ToSigned[int](n); err != nil || v != 42 {
+		t.Errorf("ToSigned(*big.Int) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToString(n); err != nil || v != "42" {
+		t.Errorf("ToString(*big.Int) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToString(netip.MustParseAddr("::1")); err != nil || v != "::1" {
+		t.Errorf("ToString(netip.Addr) = %v, %v", v, err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	type Server struct {
+		Host netip.Addr
+		Port uint16 `cast:"port"`
+	}
+
+	type Config struct {
+		Name    string
+		Debug   bool `cast:"debug"`
+		Servers []Server
+		Limits  map[string]int
+		Ignored string `cast:"-"`
+		Weight  *float64
+	}
+
+	input := map[string]any{
+		"name":  "app",
+		"debug": "true",
+		"servers": []any{
+			map[string]any{"host": "10.0.0.1", "port": "8080"},
+		},
+		"limits":  map[string]any{"rate": "10"},
+		"ignored": "value",
+		"weight":  "1.5",
+	}
+
+	var cfg Config
+	if err := gocast.Decode(input, &cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Config{
+		Name:    "app",
+		Debug:   true,
+		Servers: []Server{{Host: netip.MustParseAddr("10.0.0.1"), Port: 8080}},
+		Limits:  map[string]int{"rate": 10},
+	}
+	if cfg.Weight == nil || *cfg.Weight != 1.5 {
+		t.Errorf("Decode() weight = %v, expected 1.5", cfg.Weight)
+	}
+	cfg.Weight = nil
+
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Decode() = %+v, expected %+v", cfg, expected)
+	}
+
+	input["servers"] = []any{map[string]any{"port": "99999"}}
+	err := gocast.Decode(input, &cfg)
+	var fe *gocast.FieldError
+	if !errors.As(err, &fe) || fe.Path != "Servers[0].port" {
+		t.Errorf("Decode() error = %v, expected field error", err)
+	}
+}
@@ -0,0 +1,275 @@
+package gocast
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MergeStrategy defines how slices are merged by Document.Merge.
+// Maps are always merged recursively and other values are replaced.
+type MergeStrategy int
+
+const (
+	// MergeReplace replaces slices with the merged slice.
+	MergeReplace MergeStrategy = iota
+	// MergeAppend appends the items of the merged slice.
+	MergeAppend
+	// MergeDeep merges slice items by index, nested maps are merged recursively.
+	MergeDeep
+)
+
+// Document is a mutable nested map[string]any with the Caster API for reads.
+// Paths use the Caster.Get syntax, e.g. "db.hosts.0" or "db.hosts[0]".
+// Document is not safe for concurrent use.
+type Document struct {
+	casterDriver
+}
+
+// NewDocument creates a new Document wrapping the map, a nil map creates an empty document.
+// The map is modified in place by the document methods.
+func NewDocument(data map[string]any) *Document {
+	return defaultConverter.NewDocument(data)
+}
+
+// NewDocument creates a new Document wrapping the map that converts using the converter options.
+func (c *Converter) NewDocument(data map[string]any) *Document {
+	if data == nil {
+		data = make(map[string]any)
+	}
+	return &Document{casterDriver{data: data, conv: c}}
+}
+
+// Map returns the underlying map of the document.
+// A zero value document is initialized with an empty map.
+func (d *Document) Map() map[string]any {
+	m, ok := d.data.(map[string]any)
+	if !ok {
+		m = make(map[string]any)
+		d.data = m
+	}
+	return m
+}
+
+// Set sets the value at the path, creating intermediate maps and slices as needed.
+// A "0" segment creates a slice, other numeric segments of new values are map keys.
+// Slices are indexed up to their length, the index of the length appends an item.
+// Setting a path through a non-container value (e.g. a string) fails.
+func (d *Document) Set(path string, value any) error {
+	segments := splitPath(path)
+	if len(segments) == 0 {
+		return fmt.Errorf("cannot set empty path")
+	}
+
+	res, err := setIn(d.Map(), segments, value)
+	if err != nil {
+		return fmt.Errorf("cannot set %q: %w", path, err)
+	}
+	d.data = res
+	return nil
+}
+
+// Delete removes the value at the path, slice items are removed by index.
+// It reports whether the value existed.
+func (d *Document) Delete(path string) bool {
+	segments := splitPath(path)
+	if len(segments) == 0 {
+		return false
+	}
+
+	res, ok := deleteIn(d.Map(), segments)
+	d.data = res
+	return ok
+}
+
+// Merge merges the other document into the document. Other can be a *Document,
+// a Caster or a map with string keys. Maps are merged recursively, slices are
+// merged using the strategy and other values are replaced. Merged values are cloned.
+func (d *Document) Merge(other any, strategy MergeStrategy) error {
+	if caster, ok := other.(Caster); ok {
+		other = caster.Interface()
+	}
+
+	src, ok := containerOf(other).(map[string]any)
+	if !ok {
+		return typeError("map[string]any")
+	}
+	mergeMaps(d.Map(), src, strategy)
+	return nil
+}
+
+// Clone returns a deep copy of the document. Maps and slices are copied,
+// other values are shared.
+func (d *Document) Clone() *Document {
+	return &Document{casterDriver{
+		data: cloneValue(d.Map()),
+		conv: d.conv,
+	}}
+}
+
+// MarshalJSON encodes the underlying map.
+func (d *Document) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.data)
+}
+
+// setIn sets the value at the segments of the node and returns the updated node.
+func setIn(node any, segments []string, value any) (any, error) {
+	if len(segments) == 0 {
+		return value, nil
+	}
+
+	key := segments[0]
+	switch val := containerOf(node).(type) {
+	case map[string]any:
+		child, err := setIn(val[key], segments[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		val[key] = child
+		return val, nil
+	case []any:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i > len(val) {
+			return nil, fmt.Errorf("invalid slice index %q", key)
+		} else if i == len(val) {
+			val = append(val, nil)
+		}
+
+		child, err := setIn(val[i], segments[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		val[i] = child
+		return val, nil
+	case nil:
+		if key == "0" {
+			return setIn(make([]any, 0, 1), segments, value)
+		}
+		return setIn(make(map[string]any), segments, value)
+	default:
+		return nil, fmt.Errorf("%T is not a map or slice", val)
+	}
+}
+
+// deleteIn removes the value at the segments of the node and returns the updated node.
+func deleteIn(node any, segments []string) (any, bool) {
+	key := segments[0]
+	switch val := containerOf(node).(type) {
+	case map[string]any:
+		child, exists := val[key]
+		if !exists {
+			return node, false
+		} else if len(segments) == 1 {
+			delete(val, key)
+			return val, true
+		}
+
+		child, ok := deleteIn(child, segments[1:])
+		val[key] = child
+		return val, ok
+	case []any:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(val) {
+			return node, false
+		} else if len(segments) == 1 {
+			return append(val[:i], val[i+1:]...), true
+		}
+
+		child, ok := deleteIn(val[i], segments[1:])
+		val[i] = child
+		return val, ok
+	default:
+		return node, false
+	}
+}
+
+// mergeMaps merges the src map into the dst map.
+func mergeMaps(dst, src map[string]any, strategy MergeStrategy) {
+	for key, value := range src {
+		dst[key] = mergeValues(dst[key], value, strategy)
+	}
+}
+
+// mergeValues merges the src value into the dst value and returns the result.
+func mergeValues(dst, src any, strategy MergeStrategy) any {
+	switch srcVal := containerOf(src).(type) {
+	case map[string]any:
+		if dstMap, ok := containerOf(dst).(map[string]any); ok {
+			mergeMaps(dstMap, srcVal, strategy)
+			return dstMap
+		}
+	case []any:
+		dstSlice, ok := containerOf(dst).([]any)
+		if !ok {
+			break
+		}
+
+		switch strategy {
+		case MergeAppend:
+			return append(dstSlice, cloneValue(srcVal).([]any)...)
+		case MergeDeep:
+			for i, item := range srcVal {
+				if i < len(dstSlice) {
+					dstSlice[i] = mergeValues(dstSlice[i], item, strategy)
+				} else {
+					dstSlice = append(dstSlice, cloneValue(item))
+				}
+			}
+			return dstSlice
+		}
+	}
+	return cloneValue(src)
+}
+
+// cloneValue returns a deep copy of maps and slices, other values are returned as is.
+func cloneValue(value any) any {
+	switch val := containerOf(value).(type) {
+	case map[string]any:
+		res := make(map[string]any, len(val))
+		for key, item := range val {
+			res[key] = cloneValue(item)
+		}
+		return res
+	case []any:
+		res := make([]any, len(val))
+		for i, item := range val {
+			res[i] = cloneValue(item)
+		}
+		return res
+	default:
+		return value
+	}
+}
+
+// containerOf returns maps with string keys as map[string]any and slices or arrays as []any.
+// Typed containers are copied, other values are returned as is.
+func containerOf(value any) any {
+	switch value.(type) {
+	case nil, map[string]any, []any, []byte:
+		return value
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return value
+		}
+
+		res := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			res[iter.Key().String()] = iter.Value().Interface()
+		}
+		return res
+	case reflect.Slice, reflect.Array:
+		res := make([]any, rv.Len())
+		for i := range res {
+			res[i] = rv.Index(i).Interface()
+		}
+		return res
+	default:
+		return value
+	}
+}
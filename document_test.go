@@ -0,0 +1,161 @@
+package gocast_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestDocumentSet(t *testing.T) {
+	doc := gocast.NewDocument(nil)
+	steps := []struct {
+		path  string
+		value any
+	}{
+		{"http.port", "8080"},
+		{"db.hosts.0", "primary"},
+		{"db.hosts[1]", "replica"},
+		{"users.0.name", "admin"},
+		{"users[0].roles", []string{"root"}},
+	}
+
+	for _, step := range steps {
+		if err := doc.Set(step.path, step.value); err != nil {
+			t.Fatalf("Set(%s) error = %v", step.path, err)
+		}
+	}
+
+	expected := map[string]any{
+		"http":  map[string]any{"port": "8080"},
+		"db":    map[string]any{"hosts": []any{"primary", "replica"}},
+		"users": []any{map[string]any{"name": "admin", "roles": []string{"root"}}},
+	}
+	if !reflect.DeepEqual(doc.Map(), expected) {
+		t.Errorf("Set() = %v, expected %v", doc.Map(), expected)
+	}
+
+	if v := doc.Get("http.port").IntSafe(0); v != 8080 {
+		t.Errorf("Get(http.port) = %v, expected 8080", v)
+	}
+
+	if err := doc.Set("http.port.value", 1); err == nil {
+		t.Errorf("Set() through a string expected error")
+	}
+
+	if err := doc.Set("db.hosts.x", 1); err == nil {
+		t.Errorf("Set() with invalid slice index expected error")
+	}
+	if err := doc.Set("db.hosts.999999999", 1); err == nil {
+		t.Errorf("Set() with index out of range expected error")
+	}
+	if err := doc.Set("ids.999999999", 1); err != nil || !reflect.DeepEqual(doc.Map()["ids"], map[string]any{"999999999": 1}) {
+		t.Errorf("Set() with large numeric key = %v, %v, expected map", doc.Map()["ids"], err)
+	}
+
+	var zero gocast.Document
+	if m := zero.Map(); m == nil || len(m) != 0 {
+		t.Errorf("zero Document Map() = %v, expected empty map", m)
+	}
+	if err := zero.Set("a", 1); err != nil || zero.Get("a").IntSafe(0) != 1 {
+		t.Errorf("zero Document Set() error = %v", err)
+	}
+}
+
+func TestDocumentDelete(t *testing.T) {
+	doc := gocast.NewDocument(map[string]any{
+		"a":     map[string]any{"b": 1, "c": 2},
+		"items": []any{"x", "y", "z"},
+	})
+
+	if !doc.Delete("a.b") || !doc.Get("a.b").IsNil() {
+		t.Errorf("Delete(a.b) failed")
+	}
+
+	if !doc.Delete("items[1]") {
+		t.Errorf("Delete(items[1]) failed")
+	}
+	if v := doc.Get("items").StringSliceSafe(nil); !reflect.DeepEqual(v, []string{"x", "z"}) {
+		t.Errorf("items after Delete = %v", v)
+	}
+
+	if doc.Delete("missing.key") || doc.Delete("items.5") {
+		t.Errorf("Delete() of missing path reported true")
+	}
+}
+
+func TestDocumentMerge(t *testing.T) {
+	base := func() *gocast.Document {
+		return gocast.NewDocument(map[string]any{
+			"name":  "base",
+			"db":    map[string]any{"host": "localhost", "port": 5432},
+			"tags":  []any{"a", "b"},
+			"nodes": []any{map[string]any{"id": 1, "zone": "eu"}},
+		})
+	}
+
+	overlay := map[string]any{
+		"db":    map[string]any{"host": "db.internal"},
+		"tags":  []string{"c"},
+		"nodes": []any{map[string]any{"zone": "us"}, map[string]any{"id": 2}},
+	}
+
+	tests := []struct {
+		strategy gocast.MergeStrategy
+		tags     []string
+		nodes    []any
+	}{
+		{gocast.MergeReplace, []string{"c"}, []any{map[string]any{"zone": "us"}, map[string]any{"id": 2}}},
+		{gocast.MergeAppend, []string{"a", "b", "c"}, []any{
+			map[string]any{"id": 1, "zone": "eu"}, map[string]any{"zone": "us"}, map[string]any{"id": 2},
+		}},
+		{gocast.MergeDeep, []string{"c", "b"}, []any{map[string]any{"id": 1, "zone": "us"}, map[string]any{"id": 2}}},
+	}
+
+	for _, test := range tests {
+		doc := base()
+		if err := doc.Merge(overlay, test.strategy); err != nil {
+			t.Fatal(err)
+		}
+
+		if v := doc.Get("db.host").StringSafe(""); v != "db.internal" {
+			t.Errorf("strategy %v: db.host = %v", test.strategy, v)
+		}
+		if v := doc.Get("db.port").IntSafe(0); v != 5432 {
+			t.Errorf("strategy %v: db.port = %v", test.strategy, v)
+		}
+		if v := doc.Get("tags").StringSliceSafe(nil); !reflect.DeepEqual(v, test.tags) {
+			t.Errorf("strategy %v: tags = %v, expected %v", test.strategy, v, test.tags)
+		}
+		if v := doc.Get("nodes").Interface(); !reflect.DeepEqual(v, test.nodes) {
+			t.Errorf("strategy %v: nodes = %v, expected %v", test.strategy, v, test.nodes)
+		}
+	}
+
+	if err := base().Merge("invalid", gocast.MergeDeep); !gocast.IsCastError(err) {
+		t.Errorf("Merge(string) error = %v, expected cast error", err)
+	}
+}
+
+func TestDocumentClone(t *testing.T) {
+	doc := gocast.NewDocument(map[string]any{"a": map[string]any{"b": []any{1}}})
+	clone := doc.Clone()
+	if err := clone.Set("a.b.0", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := doc.Get("a.b.0").IntSafe(0); v != 1 {
+		t.Errorf("original changed by clone, a.b.0 = %v", v)
+	}
+
+	other := gocast.NewDocument(nil)
+	if err := other.Merge(clone, gocast.MergeReplace); err != nil || other.Get("a.b.0").IntSafe(0) != 2 {
+		t.Errorf("Merge(Document) = %v, %v", other.Map(), err)
+	}
+
+	data, err := json.Marshal(other)
+	if err != nil || string(data) != `{"a":{"b":[2]}}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+}
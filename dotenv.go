@@ -0,0 +1,203 @@
+package gocast
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ParseDotenv parses the dotenv document and returns a navigable Caster.
+// Keys are lowercased and nested by underscore as in Environment.Prefix,
+// e.g. DB_HOST is accessible as "db.host". Lines may start with "export" and
+// contain comments. Single quoted values are literal, double quoted values
+// support \n, \r, \t, \", \\ and \$ escapes, and both may span multiple lines.
+// Unquoted and double quoted values expand $VAR, ${VAR} and ${VAR:-default}
+// references to previously defined keys or the os environment.
+// Options configure the document size limit (WithMaxSize) and the conversions of the returned Caster.
+func ParseDotenv(r io.Reader, opts ...Option) (Caster, error) {
+	return NewConverter(opts...).ParseDotenv(r)
+}
+
+// ParseDotenv parses the dotenv document and returns a navigable Caster using the converter options.
+func (c *Converter) ParseDotenv(r io.Reader) (Caster, error) {
+	data, err := c.readAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseDotenv(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return c.NewCaster(nestVariables(values)), nil
+}
+
+// parseDotenv parses the dotenv lines into expanded values by key.
+func parseDotenv(data string) (map[string]string, error) {
+	values := make(map[string]string)
+	env := envDriver{lookup: func(key string) (string, bool) {
+		if v, ok := values[key]; ok {
+			return v, true
+		}
+		return os.LookupEnv(key)
+	}}
+
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		number := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if rest, ok := strings.CutPrefix(line, "export"); ok && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			line = strings.TrimSpace(rest)
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, dotenvError(number, "expected KEY=value")
+		}
+
+		value = strings.TrimLeft(value, " \t")
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			if end := inlineComment(value); end >= 0 {
+				value = value[:end]
+			}
+			values[key] = env.expandVars(strings.TrimSpace(value))
+			continue
+		}
+
+		// Quoted values may continue on the next lines
+		quote := value[0]
+		value = value[1:]
+		end := closingQuote(value, quote)
+		for end < 0 && i+1 < len(lines) {
+			i++
+			value += "\n" + lines[i]
+			end = closingQuote(value, quote)
+		}
+
+		if end < 0 {
+			return nil, dotenvError(number, "unterminated quoted value")
+		}
+
+		if rest := strings.TrimSpace(value[end+1:]); rest != "" && rest[0] != '#' {
+			return nil, dotenvError(number, "unexpected data after quoted value")
+		}
+
+		if quote == '\'' {
+			values[key] = value[:end]
+		} else {
+			values[key] = env.unescape(value[:end])
+		}
+	}
+	return values, nil
+}
+
+// closingQuote returns the index of the unescaped closing quote, or -1.
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote == '"':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// inlineComment returns the index of the comment of an unquoted value, or -1.
+func inlineComment(s string) int {
+	for i := 1; i < len(s); i++ {
+		if s[i] == '#' && (s[i-1] == ' ' || s[i-1] == '\t') {
+			return i
+		}
+	}
+	return -1
+}
+
+// unescape resolves the escapes and references of a double quoted value.
+func (env envDriver) unescape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			if n := env.writeVar(&sb, s, i); n > 0 {
+				i += n - 1
+			} else {
+				sb.WriteByte(s[i])
+			}
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case '"', '\\', '$':
+			sb.WriteByte(s[i])
+		default:
+			sb.WriteByte('\\')
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
+
+// expandVars resolves the $VAR, ${VAR} and ${VAR:-default} references of the string.
+func (env envDriver) expandVars(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if n := env.writeVar(&sb, s, i); n > 0 {
+			i += n - 1
+		} else {
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
+
+// writeVar writes the resolved reference at index i and returns its length, or 0 if there is no reference.
+func (env envDriver) writeVar(sb *strings.Builder, s string, i int) int {
+	if s[i] != '$' || i+1 == len(s) {
+		return 0
+	}
+
+	if s[i+1] == '{' {
+		end := closingBrace(s, i+1)
+		if end < 0 {
+			return 0
+		}
+		sb.WriteString(env.resolve(s[i+2 : end]))
+		return end - i + 1
+	}
+
+	end := i + 1
+	for end < len(s) && isVarChar(s[end]) {
+		end++
+	}
+	if end == i+1 {
+		return 0
+	}
+
+	v, _ := env.lookup(s[i+1 : end])
+	sb.WriteString(v)
+	return end - i
+}
+
+// isVarChar checks if the byte is allowed in variable names.
+func isVarChar(b byte) bool {
+	return b == '_' || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+// dotenvError returns a dotenv syntax error of the line.
+func dotenvError(line int, msg string) error {
+	return fmt.Errorf("invalid dotenv: line %d: %s", line, msg)
+}
@@ -0,0 +1,81 @@
+package gocast_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestParseDotenv(t *testing.T) {
+	t.Setenv("GOCAST_TEST_HOME", "/home/app")
+	input := strings.Join([]string{
+		"# comment",
+		"export APP_PORT=8080",
+		"APP_DEBUG = true # inline comment",
+		"APP_NAME='literal ${APP_PORT} # not a comment'",
+		`APP_GREETING="hello\tworld\n\"quoted\" \$HOME"`,
+		`APP_URL="http://localhost:${APP_PORT}/${APP_PATH:-api}"`,
+		"APP_DIR=$GOCAST_TEST_HOME/data",
+		"APP_HOSTS=a,b,c",
+		`APP_CERT="-----BEGIN-----`,
+		"line",
+		`-----END-----"`,
+		"EMPTY=",
+	}, "\r\n")
+
+	doc, err := gocast.ParseDotenv(strings.NewReader(input), gocast.WithSliceDelimiter(","))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"app.port", "8080"},
+		{"app.debug", "true"},
+		{"app.name", "literal ${APP_PORT} # not a comment"},
+		{"app.greeting", "hello\tworld\n\"quoted\" $HOME"},
+		{"app.url", "http://localhost:8080/api"},
+		{"app.dir", "/home/app/data"},
+		{"app.cert", "-----BEGIN-----\nline\n-----END-----"},
+		{"empty", ""},
+	}
+
+	for _, test := range tests {
+		if v := doc.Get(test.path).StringSafe("<nil>"); v != test.expected {
+			t.Errorf("Get(%s) = %q, expected %q", test.path, v, test.expected)
+		}
+	}
+
+	if v := doc.Get("app.port").IntSafe(0); v != 8080 {
+		t.Errorf("app.port = %v, expected 8080", v)
+	}
+	if !doc.Get("app.debug").BoolSafe(false) {
+		t.Errorf("app.debug expected true")
+	}
+	if v := doc.Get("app.hosts").StringSliceSafe(nil); !reflect.DeepEqual(v, []string{"a", "b", "c"}) {
+		t.Errorf("app.hosts = %v", v)
+	}
+}
+
+func TestParseDotenvErrors(t *testing.T) {
+	tests := []string{
+		"INVALID",
+		"BAD KEY=1",
+		"A=\"unterminated",
+		"A='value' trailing",
+	}
+
+	for _, input := range tests {
+		if _, err := gocast.ParseDotenv(strings.NewReader(input)); err == nil || !strings.Contains(err.Error(), "line 1") {
+			t.Errorf("ParseDotenv(%q) error = %v, expected line error", input, err)
+		}
+	}
+
+	if _, err := gocast.ParseDotenv(strings.NewReader("A=1234567890"), gocast.WithMaxSize(5)); !gocast.IsLimitError(err) {
+		t.Errorf("ParseDotenv() over size error = %v, expected limit error", err)
+	}
+}
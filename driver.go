@@ -2,379 +2,145 @@ package gocast
 
 import (
 	"encoding/json"
-	"fmt"
+	"iter"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
 )
 
 type casterDriver struct {
 	data any
+	conv *Converter
 }
 
-func (driver casterDriver) IsNil() bool {
-	return valueOf(driver.data) == nil
-}
-
-func (driver casterDriver) Interface() any {
-	return driver.data
-}
-
-func (driver casterDriver) Unmarshal(out any) error {
-	// Try direct unmarshal
-	err := json.Unmarshal([]byte(fmt.Sprintf("%v", valueOf(driver.data))), out)
-	if err == nil {
-		return nil
-	}
-
-	// Try marshal and unmarshal
-	bytes, err := json.Marshal(driver.data)
-	if err != nil {
-		return err
-	}
-	return json.Unmarshal(bytes, out)
-}
-
-func (driver casterDriver) Bool() (bool, error) {
-	return ToBool(driver.data)
-}
-
-func (driver casterDriver) BoolSafe(fallback bool) bool {
-	val, err := driver.Bool()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Int() (int, error) {
-	return ToSigned[int](driver.data)
-}
-
-func (driver casterDriver) IntSafe(fallback int) int {
-	val, err := driver.Int()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Int8() (int8, error) {
-	return ToSigned[int8](driver.data)
-}
-
-func (driver casterDriver) Int8Safe(fallback int8) int8 {
-	val, err := driver.Int8()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Int16() (int16, error) {
-	return ToSigned[int16](driver.data)
-}
-
-func (driver casterDriver) Int16Safe(fallback int16) int16 {
-	val, err := driver.Int16()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Int32() (int32, error) {
-	return ToSigned[int32](driver.data)
-}
-
-func (driver casterDriver) Int32Safe(fallback int32) int32 {
-	val, err := driver.Int32()
-	if err != nil {
-		return fallback
+// converter returns the driver converter or the default converter if not set.
+func (driver casterDriver) converter() *Converter {
+	if driver.conv == nil {
+		return defaultConverter
 	}
-	return val
+	return driver.conv
 }
 
-func (driver casterDriver) Int64() (int64, error) {
-	return ToSigned[int64](driver.data)
-}
-
-func (driver casterDriver) Int64Safe(fallback int64) int64 {
-	val, err := driver.Int64()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Uint() (uint, error) {
-	return ToUnsigned[uint](driver.data)
-}
-
-func (driver casterDriver) UintSafe(fallback uint) uint {
-	val, err := driver.Uint()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Uint8() (uint8, error) {
-	return ToUnsigned[uint8](driver.data)
-}
-
-func (driver casterDriver) Uint8Safe(fallback uint8) uint8 {
-	val, err := driver.Uint8()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Uint16() (uint16, error) {
-	return ToUnsigned[uint16](driver.data)
-}
-
-func (driver casterDriver) Uint16Safe(fallback uint16) uint16 {
-	val, err := driver.Uint16()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Uint32() (uint32, error) {
-	return ToUnsigned[uint32](driver.data)
-}
-
-func (driver casterDriver) Uint32Safe(fallback uint32) uint32 {
-	val, err := driver.Uint32()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Uint64() (uint64, error) {
-	return ToUnsigned[uint64](driver.data)
-}
-
-func (driver casterDriver) Uint64Safe(fallback uint64) uint64 {
-	val, err := driver.Uint64()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Float32() (float32, error) {
-	return ToFloat[float32](driver.data)
-}
-
-func (driver casterDriver) Float32Safe(fallback float32) float32 {
-	val, err := driver.Float32()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Float64() (float64, error) {
-	return ToFloat[float64](driver.data)
-}
-
-func (driver casterDriver) Float64Safe(fallback float64) float64 {
-	val, err := driver.Float64()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) String() (string, error) {
-	return ToString(driver.data)
-}
-
-func (driver casterDriver) StringSafe(fallback string) string {
-	val, err := driver.String()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Slice() ([]any, error) {
-	return ToSlice(driver.data)
+func (driver casterDriver) IsNil() bool {
+	return valueOf(driver.data) == nil
 }
 
-func (driver casterDriver) SliceSafe(fallback []any) []any {
-	if res, err := driver.Slice(); err == nil {
-		return res
+func (driver casterDriver) IsEmpty() bool {
+	value := valueOf(driver.data)
+	if s, ok := value.(string); ok {
+		return strings.TrimSpace(s) == ""
 	}
-	return fallback
-}
-
-func (driver casterDriver) BoolSlice() ([]bool, error) {
-	return ToBoolSlice(driver.data)
-}
 
-func (driver casterDriver) BoolSliceSafe(fallback []bool) []bool {
-	if res, err := driver.BoolSlice(); err == nil {
-		return res
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return val.Len() == 0
+	default:
+		return false
 	}
-	return fallback
 }
 
-func (driver casterDriver) IntSlice() ([]int, error) {
-	return ToSignedSlice[int](driver.data)
+func (driver casterDriver) IsZero() bool {
+	return driver.IsEmpty() || isEmptyValue(driver.data)
 }
 
-func (driver casterDriver) IntSliceSafe(fallback []int) []int {
-	val, err := driver.IntSlice()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Int8Slice() ([]int8, error) {
-	return ToSignedSlice[int8](driver.data)
-
-}
-
-func (driver casterDriver) Int8SliceSafe(fallback []int8) []int8 {
-	val, err := driver.Int8Slice()
-	if err != nil {
-		return fallback
-	}
-	return val
-}
-
-func (driver casterDriver) Int16Slice() ([]int16, error) {
-	return ToSignedSlice[int16](driver.data)
+func (driver casterDriver) Interface() any {
+	return driver.data
 }
 
-func (driver casterDriver) Int16SliceSafe(fallback []int16) []int16 {
-	val, err := driver.Int16Slice()
-	if err != nil {
-		return fallback
+func (driver casterDriver) Unmarshal(out any) error {
+	// Try direct unmarshal of json text
+	switch val := valueOf(driver.data).(type) {
+	case string:
+		if err := json.Unmarshal([]byte(val), out); err == nil {
+			return nil
+		}
+	case []byte:
+		if err := json.Unmarshal(val, out); err == nil {
+			return nil
+		}
 	}
-	return val
-}
-
-func (driver casterDriver) Int32Slice() ([]int32, error) {
-	return ToSignedSlice[int32](driver.data)
-}
 
-func (driver casterDriver) Int32SliceSafe(fallback []int32) []int32 {
-	val, err := driver.Int32Slice()
+	// Try marshal and unmarshal
+	bytes, err := json.Marshal(driver.data)
 	if err != nil {
-		return fallback
+		return err
 	}
-	return val
-}
-
-func (driver casterDriver) Int64Slice() ([]int64, error) {
-	return ToSignedSlice[int64](driver.data)
+	return json.Unmarshal(bytes, out)
 }
 
-func (driver casterDriver) Int64SliceSafe(fallback []int64) []int64 {
-	val, err := driver.Int64Slice()
-	if err != nil {
-		return fallback
+func (driver casterDriver) Pointer(pointer string) Caster {
+	if tokens, ok := splitPointer(pointer); ok {
+		if v, ok := lookupPath(driver.data, tokens); ok {
+			return driver.converter().NewCaster(v)
+		}
 	}
-	return val
+	return driver.converter().NewCaster(nil)
 }
 
-func (driver casterDriver) UintSlice() ([]uint, error) {
-	return ToUnsignedSlice[uint](driver.data)
+func (driver casterDriver) Query(expr string) []Caster {
+	return slices.Collect(driver.QueryIter(expr))
 }
 
-func (driver casterDriver) UintSliceSafe(fallback []uint) []uint {
-	val, err := driver.UintSlice()
-	if err != nil {
-		return fallback
-	}
-	return val
+func (driver casterDriver) QueryIter(expr string) iter.Seq[Caster] {
+	return queryValues(driver.converter(), driver.data, expr)
 }
 
-func (driver casterDriver) Uint8Slice() ([]uint8, error) {
-	return ToUnsignedSlice[uint8](driver.data)
+func (driver casterDriver) With(opts ...Option) Caster {
+	return driver.converter().With(opts...).NewCaster(driver.data)
 }
 
-func (driver casterDriver) Uint8SliceSafe(fallback []uint8) []uint8 {
-	val, err := driver.Uint8Slice()
-	if err != nil {
-		return fallback
-	}
-	return val
+func (driver casterDriver) Validate(path string) *Validator {
+	return &Validator{conv: driver.converter(), data: driver.data, path: path}
 }
 
-func (driver casterDriver) Uint16Slice() ([]uint16, error) {
-	return ToUnsignedSlice[uint16](driver.data)
-}
-
-func (driver casterDriver) Uint16SliceSafe(fallback []uint16) []uint16 {
-	val, err := driver.Uint16Slice()
-	if err != nil {
-		return fallback
-	}
-	return val
+func (driver casterDriver) Addr() (netip.Addr, error) {
+	return driver.converter().ToAddr(driver.data)
 }
 
-func (driver casterDriver) Uint32Slice() ([]uint32, error) {
-	return ToUnsignedSlice[uint32](driver.data)
+func (driver casterDriver) AddrSlice() ([]netip.Addr, error) {
+	return driver.converter().ToAddrSlice(driver.data)
 }
 
-func (driver casterDriver) Uint32SliceSafe(fallback []uint32) []uint32 {
-	val, err := driver.Uint32Slice()
-	if err != nil {
-		return fallback
-	}
-	return val
+func (driver casterDriver) Prefix() (netip.Prefix, error) {
+	return driver.converter().ToPrefix(driver.data)
 }
 
-func (driver casterDriver) Uint64Slice() ([]uint64, error) {
-	return ToUnsignedSlice[uint64](driver.data)
+func (driver casterDriver) PrefixSlice() ([]netip.Prefix, error) {
+	return driver.converter().ToPrefixSlice(driver.data)
 }
 
-func (driver casterDriver) Uint64SliceSafe(fallback []uint64) []uint64 {
-	val, err := driver.Uint64Slice()
-	if err != nil {
-		return fallback
-	}
-	return val
+func (driver casterDriver) AddrPort() (netip.AddrPort, error) {
+	return driver.converter().ToAddrPort(driver.data)
 }
 
-func (driver casterDriver) Float32Slice() ([]float32, error) {
-	return ToFloatSlice[float32](driver.data)
+func (driver casterDriver) AddrPortSlice() ([]netip.AddrPort, error) {
+	return driver.converter().ToAddrPortSlice(driver.data)
 }
 
-func (driver casterDriver) Float32SliceSafe(fallback []float32) []float32 {
-	val, err := driver.Float32Slice()
-	if err != nil {
-		return fallback
-	}
-	return val
+func (driver casterDriver) URL() (*url.URL, error) {
+	return driver.converter().ToURL(driver.data)
 }
 
-func (driver casterDriver) Float64Slice() ([]float64, error) {
-	return ToFloatSlice[float64](driver.data)
+func (driver casterDriver) URLSlice() ([]*url.URL, error) {
+	return driver.converter().ToURLSlice(driver.data)
 }
 
-func (driver casterDriver) Float64SliceSafe(fallback []float64) []float64 {
-	val, err := driver.Float64Slice()
-	if err != nil {
-		return fallback
-	}
-	return val
+func (driver casterDriver) HostPort(defaultPort int) (string, error) {
+	return driver.converter().ToHostPort(driver.data, defaultPort)
 }
 
-func (driver casterDriver) StringSlice() ([]string, error) {
-	return ToStringSlice(driver.data)
+func (driver casterDriver) HostPortSlice(defaultPort int) ([]string, error) {
+	return driver.converter().ToHostPortSlice(driver.data, defaultPort)
 }
 
-func (driver casterDriver) StringSliceSafe(fallback []string) []string {
-	val, err := driver.StringSlice()
-	if err != nil {
-		return fallback
+func (driver casterDriver) Get(path string) Caster {
+	if v, ok := lookupPath(driver.data, splitPath(path)); ok {
+		return driver.converter().NewCaster(v)
 	}
-	return val
+	return driver.converter().NewCaster(nil)
 }
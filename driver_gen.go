@@ -0,0 +1,463 @@
+// Code generated by gocastgen. DO NOT EDIT.
+
+package gocast
+
+func (driver casterDriver) Bool() (bool, error) {
+	return driver.converter().ToBool(driver.data)
+}
+
+func (driver casterDriver) BoolSafe(fallback bool) bool {
+	val, err := driver.Bool()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) BoolOptional() (Optional[bool], error) {
+	return toOptional[bool](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) BoolPtr() (*bool, error) {
+	return toPtr[bool](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int() (int, error) {
+	return driver.converter().ToInt(driver.data)
+}
+
+func (driver casterDriver) IntSafe(fallback int) int {
+	val, err := driver.Int()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) IntOptional() (Optional[int], error) {
+	return toOptional[int](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) IntPtr() (*int, error) {
+	return toPtr[int](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int8() (int8, error) {
+	return driver.converter().ToInt8(driver.data)
+}
+
+func (driver casterDriver) Int8Safe(fallback int8) int8 {
+	val, err := driver.Int8()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int8Optional() (Optional[int8], error) {
+	return toOptional[int8](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int8Ptr() (*int8, error) {
+	return toPtr[int8](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int16() (int16, error) {
+	return driver.converter().ToInt16(driver.data)
+}
+
+func (driver casterDriver) Int16Safe(fallback int16) int16 {
+	val, err := driver.Int16()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int16Optional() (Optional[int16], error) {
+	return toOptional[int16](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int16Ptr() (*int16, error) {
+	return toPtr[int16](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int32() (int32, error) {
+	return driver.converter().ToInt32(driver.data)
+}
+
+func (driver casterDriver) Int32Safe(fallback int32) int32 {
+	val, err := driver.Int32()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int32Optional() (Optional[int32], error) {
+	return toOptional[int32](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int32Ptr() (*int32, error) {
+	return toPtr[int32](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int64() (int64, error) {
+	return driver.converter().ToInt64(driver.data)
+}
+
+func (driver casterDriver) Int64Safe(fallback int64) int64 {
+	val, err := driver.Int64()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int64Optional() (Optional[int64], error) {
+	return toOptional[int64](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Int64Ptr() (*int64, error) {
+	return toPtr[int64](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint() (uint, error) {
+	return driver.converter().ToUint(driver.data)
+}
+
+func (driver casterDriver) UintSafe(fallback uint) uint {
+	val, err := driver.Uint()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) UintOptional() (Optional[uint], error) {
+	return toOptional[uint](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) UintPtr() (*uint, error) {
+	return toPtr[uint](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint8() (uint8, error) {
+	return driver.converter().ToUint8(driver.data)
+}
+
+func (driver casterDriver) Uint8Safe(fallback uint8) uint8 {
+	val, err := driver.Uint8()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint8Optional() (Optional[uint8], error) {
+	return toOptional[uint8](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint8Ptr() (*uint8, error) {
+	return toPtr[uint8](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint16() (uint16, error) {
+	return driver.converter().ToUint16(driver.data)
+}
+
+func (driver casterDriver) Uint16Safe(fallback uint16) uint16 {
+	val, err := driver.Uint16()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint16Optional() (Optional[uint16], error) {
+	return toOptional[uint16](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint16Ptr() (*uint16, error) {
+	return toPtr[uint16](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint32() (uint32, error) {
+	return driver.converter().ToUint32(driver.data)
+}
+
+func (driver casterDriver) Uint32Safe(fallback uint32) uint32 {
+	val, err := driver.Uint32()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint32Optional() (Optional[uint32], error) {
+	return toOptional[uint32](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint32Ptr() (*uint32, error) {
+	return toPtr[uint32](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint64() (uint64, error) {
+	return driver.converter().ToUint64(driver.data)
+}
+
+func (driver casterDriver) Uint64Safe(fallback uint64) uint64 {
+	val, err := driver.Uint64()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint64Optional() (Optional[uint64], error) {
+	return toOptional[uint64](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Uint64Ptr() (*uint64, error) {
+	return toPtr[uint64](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Float32() (float32, error) {
+	return driver.converter().ToFloat32(driver.data)
+}
+
+func (driver casterDriver) Float32Safe(fallback float32) float32 {
+	val, err := driver.Float32()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Float32Optional() (Optional[float32], error) {
+	return toOptional[float32](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Float32Ptr() (*float32, error) {
+	return toPtr[float32](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Float64() (float64, error) {
+	return driver.converter().ToFloat64(driver.data)
+}
+
+func (driver casterDriver) Float64Safe(fallback float64) float64 {
+	val, err := driver.Float64()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Float64Optional() (Optional[float64], error) {
+	return toOptional[float64](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Float64Ptr() (*float64, error) {
+	return toPtr[float64](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) String() (string, error) {
+	return driver.converter().ToString(driver.data)
+}
+
+func (driver casterDriver) StringSafe(fallback string) string {
+	val, err := driver.String()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) StringOptional() (Optional[string], error) {
+	return toOptional[string](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) StringPtr() (*string, error) {
+	return toPtr[string](driver.converter(), driver.data)
+}
+
+func (driver casterDriver) Slice() ([]any, error) {
+	return driver.converter().ToSlice(driver.data)
+}
+
+func (driver casterDriver) SliceSafe(fallback []any) []any {
+	val, err := driver.Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) BoolSlice() ([]bool, error) {
+	return driver.converter().ToBoolSlice(driver.data)
+}
+
+func (driver casterDriver) BoolSliceSafe(fallback []bool) []bool {
+	val, err := driver.BoolSlice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) IntSlice() ([]int, error) {
+	return driver.converter().ToIntSlice(driver.data)
+}
+
+func (driver casterDriver) IntSliceSafe(fallback []int) []int {
+	val, err := driver.IntSlice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int8Slice() ([]int8, error) {
+	return driver.converter().ToInt8Slice(driver.data)
+}
+
+func (driver casterDriver) Int8SliceSafe(fallback []int8) []int8 {
+	val, err := driver.Int8Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int16Slice() ([]int16, error) {
+	return driver.converter().ToInt16Slice(driver.data)
+}
+
+func (driver casterDriver) Int16SliceSafe(fallback []int16) []int16 {
+	val, err := driver.Int16Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int32Slice() ([]int32, error) {
+	return driver.converter().ToInt32Slice(driver.data)
+}
+
+func (driver casterDriver) Int32SliceSafe(fallback []int32) []int32 {
+	val, err := driver.Int32Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Int64Slice() ([]int64, error) {
+	return driver.converter().ToInt64Slice(driver.data)
+}
+
+func (driver casterDriver) Int64SliceSafe(fallback []int64) []int64 {
+	val, err := driver.Int64Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) UintSlice() ([]uint, error) {
+	return driver.converter().ToUintSlice(driver.data)
+}
+
+func (driver casterDriver) UintSliceSafe(fallback []uint) []uint {
+	val, err := driver.UintSlice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint8Slice() ([]uint8, error) {
+	return driver.converter().ToUint8Slice(driver.data)
+}
+
+func (driver casterDriver) Uint8SliceSafe(fallback []uint8) []uint8 {
+	val, err := driver.Uint8Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint16Slice() ([]uint16, error) {
+	return driver.converter().ToUint16Slice(driver.data)
+}
+
+func (driver casterDriver) Uint16SliceSafe(fallback []uint16) []uint16 {
+	val, err := driver.Uint16Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint32Slice() ([]uint32, error) {
+	return driver.converter().ToUint32Slice(driver.data)
+}
+
+func (driver casterDriver) Uint32SliceSafe(fallback []uint32) []uint32 {
+	val, err := driver.Uint32Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Uint64Slice() ([]uint64, error) {
+	return driver.converter().ToUint64Slice(driver.data)
+}
+
+func (driver casterDriver) Uint64SliceSafe(fallback []uint64) []uint64 {
+	val, err := driver.Uint64Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Float32Slice() ([]float32, error) {
+	return driver.converter().ToFloat32Slice(driver.data)
+}
+
+func (driver casterDriver) Float32SliceSafe(fallback []float32) []float32 {
+	val, err := driver.Float32Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) Float64Slice() ([]float64, error) {
+	return driver.converter().ToFloat64Slice(driver.data)
+}
+
+func (driver casterDriver) Float64SliceSafe(fallback []float64) []float64 {
+	val, err := driver.Float64Slice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
+
+func (driver casterDriver) StringSlice() ([]string, error) {
+	return driver.converter().ToStringSlice(driver.data)
+}
+
+func (driver casterDriver) StringSliceSafe(fallback []string) []string {
+	val, err := driver.StringSlice()
+	if err != nil {
+		return fallback
+	}
+	return val
+}
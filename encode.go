@@ -0,0 +1,212 @@
+package gocast
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EncodeOptions configures EncodeWith.
+type EncodeOptions struct {
+	// Tag is the struct tag used for naming, defaults to "cast" as in Decode.
+	Tag string
+	// KeyCase transforms the names of untagged fields, e.g. KeyCaseSnake.
+	KeyCase KeyCase
+	// Stringify converts all values to strings using ToString.
+	Stringify bool
+}
+
+// tag returns the struct tag or the default one.
+func (o EncodeOptions) tag() string {
+	if o.Tag == "" {
+		return "cast"
+	}
+	return o.Tag
+}
+
+// Encode converts the struct (or map) into a map[string]any, the reverse of Decode.
+// See EncodeWith for details.
+func Encode(v any) (map[string]any, error) {
+	return EncodeWith(v, EncodeOptions{})
+}
+
+// EncodeWith converts the struct (or map) into a map[string]any using the options.
+// Fields are named by the `cast:"name"` tag (or the Tag option) or the field name
+// transformed by the KeyCase option. Tag options are:
+//   - omitempty: omits nil, zero and empty values.
+//   - inline or squash: merges the fields of a nested struct or map into the parent.
+//   - string: converts the value to string using ToString.
+//
+// Untagged embedded structs are inlined. Nested structs, maps and slices are
+// encoded recursively and types implementing encoding.TextMarshaler are encoded
+// as their text. Other values are kept as is.
+func EncodeWith(v any, opts EncodeOptions) (map[string]any, error) {
+	res, err := encodeValue(reflect.ValueOf(v), opts, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := res.(map[string]any)
+	if !ok {
+		return nil, typeError("map[string]any")
+	}
+	return m, nil
+}
+
+// encodeValue encodes the value at the path.
+func encodeValue(v reflect.Value, opts EncodeOptions, path string, depth int) (any, error) {
+	if depth > defaultMaxDepth {
+		return nil, fieldError(path, limitError(fmt.Sprintf("%d nesting levels", defaultMaxDepth)))
+	}
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		if v.Kind() == reflect.Ptr && v.Type().Implements(textMarshalerType) {
+			break
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	// Text marshalers
+	if text, ok, err := textOf(v.Interface()); ok {
+		if err != nil {
+			return nil, fieldError(path, err)
+		}
+		return text, nil
+	}
+
+	var res any
+	var err error
+	switch v.Kind() {
+	case reflect.Struct:
+		res, err = encodeStruct(v, opts, path, depth)
+	case reflect.Map:
+		res, err = encodeMap(v, opts, path, depth)
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			res = v.Interface()
+			break
+		} else if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil, nil
+		}
+
+		items := make([]any, v.Len())
+		for i := range items {
+			if items[i], err = encodeValue(v.Index(i), opts, indexPath(path, i), depth+1); err != nil {
+				return nil, err
+			}
+		}
+		res = items
+	default:
+		res = v.Interface()
+	}
+
+	if err != nil || !opts.Stringify {
+		return res, err
+	}
+	return stringifyValue(res, path)
+}
+
+// encodeStruct encodes the fields of the struct into a map.
+func encodeStruct(v reflect.Value, opts EncodeOptions, path string, depth int) (any, error) {
+	res := make(map[string]any)
+	var inlined []map[string]any
+	for _, field := range structFields(v.Type(), opts.tag()) {
+		name := field.name
+		if tagName, _ := parseTag(field.field.Tag.Get(opts.tag())); tagName == "" {
+			name = opts.KeyCase.apply(name)
+		}
+
+		target := v.FieldByIndex(field.index)
+		if hasOption(field.options, "omitempty") && isEmptyField(target) {
+			continue
+		}
+
+		value, err := encodeValue(target, opts, joinPath(path, name), depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if hasOption(field.options, "string") && !opts.Stringify {
+			if value, err = stringifyValue(value, joinPath(path, name)); err != nil {
+				return nil, err
+			}
+		}
+
+		// Inline nested structs and maps, nil values have no fields to inline
+		if hasOption(field.options, "inline") || hasOption(field.options, "squash") {
+			if value == nil {
+				continue
+			} else if nested, ok := value.(map[string]any); ok {
+				inlined = append(inlined, nested)
+				continue
+			}
+		}
+		res[name] = value
+	}
+	mergeInlined(res, inlined)
+	return res, nil
+}
+
+// mergeInlined adds the entries of the inlined maps missing from res.
+// Parent fields win over inlined entries and earlier inlined fields over later ones.
+func mergeInlined(res map[string]any, inlined []map[string]any) {
+	for _, nested := range inlined {
+		for key, item := range nested {
+			if _, exists := res[key]; !exists {
+				res[key] = item
+			}
+		}
+	}
+}
+
+// encodeMap encodes the map entries, keys are converted to strings.
+func encodeMap(v reflect.Value, opts EncodeOptions, path string, depth int) (any, error) {
+	if v.IsNil() {
+		return nil, nil
+	}
+
+	res := make(map[string]any, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := ToString(iter.Key().Interface())
+		if err != nil {
+			return nil, fieldError(path, err)
+		}
+
+		if res[key], err = encodeValue(iter.Value(), opts, joinPath(path, key), depth+1); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// stringifyValue converts the encoded scalar value to string, maps and slices are kept as is.
+func stringifyValue(value any, path string) (any, error) {
+	switch value.(type) {
+	case nil, map[string]any, []any:
+		return value, nil
+	}
+
+	s, err := ToString(value)
+	if err != nil {
+		return nil, fieldError(path, err)
+	}
+	return s, nil
+}
+
+// isEmptyField checks if the field is nil, zero or an empty slice or map.
+func isEmptyField(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
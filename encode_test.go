@@ -0,0 +1,142 @@
+package gocast_test
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+type encodeBase struct {
+	ID      int       `cast:"id"`
+	Created time.Time `cast:"created,omitempty"`
+}
+
+type encodeAddress struct {
+	City string `cast:"city"`
+	Zip  string `cast:"zip,omitempty"`
+}
+
+type encodeUser struct {
+	encodeBase
+	Name     string            `cast:"name"`
+	HTTPPort int               `cast:",string"`
+	Email    *string           `cast:"email,omitempty"`
+	Tags     []string          `cast:"tags,omitempty"`
+	Address  encodeAddress     `cast:"address"`
+	Extra    encodeAddress     `cast:",inline"`
+	Labels   map[string]int    `cast:"labels"`
+	IP       netip.Addr        `cast:"ip"`
+	Secret   string            `cast:"-"`
+	Items    []encodeAddress   `cast:"items"`
+	Meta     map[int]time.Time `cast:"meta,omitempty"`
+	private  string
+}
+
+func TestEncode(t *testing.T) {
+	user := encodeUser{
+		encodeBase: encodeBase{ID: 7},
+		Name:       "admin",
+		HTTPPort:   8080,
+		Address:    encodeAddress{City: "Berlin"},
+		Extra:      encodeAddress{City: "ignored", Zip: "10115"},
+		Labels:     map[string]int{"a": 1},
+		IP:         netip.MustParseAddr("10.0.0.1"),
+		Secret:     "secret",
+		Items:      []encodeAddress{{City: "Paris"}},
+		private:    "private",
+	}
+
+	result, err := gocast.Encode(&user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]any{
+		"id":       7,
+		"name":     "admin",
+		"HTTPPort": "8080",
+		"address":  map[string]any{"city": "Berlin"},
+		"city":     "ignored",
+		"zip":      "10115",
+		"labels":   map[string]any{"a": 1},
+		"ip":       "10.0.0.1",
+		"items":    []any{map[string]any{"city": "Paris"}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Encode() = %#v, expected %#v", result, expected)
+	}
+
+	caster := gocast.NewCaster(result)
+	if v := caster.Get("items.0.city").StringSafe(""); v != "Paris" {
+		t.Errorf("Get(items.0.city) = %v", v)
+	}
+	if v := caster.Get("HTTPPort").IntSafe(0); v != 8080 {
+		t.Errorf("Get(HTTPPort) = %v", v)
+	}
+
+	var decoded encodeUser
+	if err := gocast.Decode(result, &decoded); err != nil || decoded.Name != "admin" || decoded.IP != user.IP || decoded.ID != 7 {
+		t.Errorf("Decode(Encode()) = %+v, %v", decoded, err)
+	}
+	inline := struct {
+		Name    string            `cast:"name"`
+		Address *encodeAddress    `cast:",inline"`
+		Labels  map[string]string `cast:",squash"`
+		Tags    []int             `cast:",inline"`
+	}{Name: "x"}
+	if result, err := gocast.Encode(inline); err != nil || !reflect.DeepEqual(result, map[string]any{"name": "x"}) {
+		t.Errorf("Encode() with nil inline fields = %#v, %v", result, err)
+	}
+
+	clash := struct {
+		Address encodeAddress `cast:",inline"`
+		City    string        `cast:"city"`
+	}{Address: encodeAddress{City: "inlined"}, City: "parent"}
+	if result, err := gocast.Encode(clash); err != nil || !reflect.DeepEqual(result, map[string]any{"city": "parent"}) {
+		t.Errorf("Encode() with inline field before parent field = %#v, %v", result, err)
+	}
+}
+
+func TestEncodeWith(t *testing.T) {
+	type Config struct {
+		HTTPPort    int
+		DBHost      string
+		MaxIdleConn int `json:"max_idle"`
+		Timeout     time.Duration
+	}
+
+	cfg := Config{HTTPPort: 80, DBHost: "db", MaxIdleConn: 2, Timeout: time.Second}
+	tests := []struct {
+		opts     gocast.EncodeOptions
+		expected map[string]any
+	}{
+		{gocast.EncodeOptions{KeyCase: gocast.KeyCaseSnake}, map[string]any{
+			"http_port": 80, "db_host": "db", "max_idle_conn": 2, "timeout": time.Second,
+		}},
+		{gocast.EncodeOptions{Tag: "json", KeyCase: gocast.KeyCaseCamel, Stringify: true}, map[string]any{
+			"httpPort": "80", "dbHost": "db", "max_idle": "2", "timeout": "1s",
+		}},
+		{gocast.EncodeOptions{KeyCase: gocast.KeyCaseKebab}, map[string]any{
+			"http-port": 80, "db-host": "db", "max-idle-conn": 2, "timeout": time.Second,
+		}},
+	}
+
+	for _, test := range tests {
+		result, err := gocast.EncodeWith(cfg, test.opts)
+		if err != nil {
+			t.Errorf("EncodeWith(%+v) error = %v", test.opts, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("EncodeWith(%+v) = %v, expected %v", test.opts, result, test.expected)
+		}
+	}
+
+	if _, err := gocast.Encode(42); !gocast.IsCastError(err) {
+		t.Errorf("Encode(42) error = %v, expected cast error", err)
+	}
+}
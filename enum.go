@@ -0,0 +1,216 @@
+package gocast
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type enumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~string
+}
+
+// EnumOptions configures a registered enum.
+type EnumOptions[T enumType] struct {
+	// Aliases are additional accepted names, e.g. {"enabled": StatusActive}.
+	// Aliases are not used for formatting.
+	Aliases map[string]T
+	// CaseSensitive disables case-insensitive matching of names.
+	CaseSensitive bool
+}
+
+// enumInfo is the type-erased registration of an enum type.
+type enumInfo struct {
+	typ           reflect.Type
+	values        map[string]any
+	names         map[any]string
+	allowed       []string
+	caseSensitive bool
+}
+
+// enums holds the registered enums by type.
+var enums sync.Map
+
+// RegisterEnum registers the names of the enum type T, e.g. {"active": StatusActive}.
+// Registered enums are converted by ToEnum and Decode from names (case-insensitive by default),
+// numeric values and Stringer output, and formatted by ToString using their names.
+// If several names map to the same value, the first one in sorted order is used for formatting.
+// Registering the type again replaces the previous registration.
+func RegisterEnum[T enumType](values map[string]T, opts EnumOptions[T]) {
+	info := &enumInfo{
+		typ:           reflect.TypeFor[T](),
+		values:        make(map[string]any, len(values)+len(opts.Aliases)),
+		names:         make(map[any]string, len(values)),
+		caseSensitive: opts.CaseSensitive,
+	}
+
+	for name, value := range opts.Aliases {
+		info.values[info.key(name)] = value
+	}
+
+	for name, value := range values {
+		info.values[info.key(name)] = value
+		info.allowed = append(info.allowed, name)
+	}
+
+	slices.Sort(info.allowed)
+	for _, name := range info.allowed {
+		if _, ok := info.names[values[name]]; !ok {
+			info.names[values[name]] = name
+		}
+	}
+	enums.Store(info.typ, info)
+}
+
+// ToEnum casts an interface to the registered enum type T.
+// Names, numeric values and Stringer output are accepted, unknown values
+// fail with a casting error listing the allowed names.
+func ToEnum[T enumType](value any) (T, error) {
+	return toEnum[T](defaultConverter, value)
+}
+
+// ToEnumWith casts an interface to the registered enum type T using the converter options.
+func ToEnumWith[T enumType](c *Converter, value any) (T, error) {
+	return toEnum[T](c, value)
+}
+
+// toEnum casts an interface to the registered enum type T using the converter options.
+func toEnum[T enumType](c *Converter, value any) (T, error) {
+	info, ok := enumOf(reflect.TypeFor[T]())
+	if !ok {
+		return *new(T), fmt.Errorf("enum %s is not registered", reflect.TypeFor[T]())
+	}
+
+	v, err := info.parse(c, value)
+	if err != nil {
+		return *new(T), err
+	}
+	return v.(T), nil
+}
+
+// enumOf returns the registered enum of the type.
+func enumOf(t reflect.Type) (*enumInfo, bool) {
+	info, ok := enums.Load(t)
+	if !ok {
+		return nil, false
+	}
+	return info.(*enumInfo), true
+}
+
+// enumName returns the registered name of the enum value.
+func enumName(value any) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+
+	info, ok := enumOf(reflect.TypeOf(value))
+	if !ok {
+		return "", false
+	}
+
+	name, ok := info.names[value]
+	return name, ok
+}
+
+// key returns the lookup key of the name.
+func (e *enumInfo) key(name string) string {
+	name = strings.TrimSpace(name)
+	if e.caseSensitive {
+		return name
+	}
+	return strings.ToLower(name)
+}
+
+// parse converts the value to the enum type.
+func (e *enumInfo) parse(c *Converter, value any) (any, error) {
+	value = valueOf(value)
+	if value == nil {
+		return nil, c.nilError(e.typ.String())
+	} else if reflect.TypeOf(value) == e.typ {
+		if _, ok := e.names[value]; ok {
+			return value, nil
+		}
+		return nil, e.unknown(value)
+	}
+
+	switch val := value.(type) {
+	case string:
+		return e.parseName(c, val)
+	case []byte:
+		return e.parseName(c, string(val))
+	case json.Number:
+		return e.parseName(c, string(val))
+	case StringProvider:
+		return e.parseName(c, val.String())
+	default:
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return nil, err
+			}
+			return e.parseName(c, text)
+		}
+		return e.parseNumber(c, val)
+	}
+}
+
+// parseName converts the name or numeric string to the enum type.
+func (e *enumInfo) parseName(c *Converter, name string) (any, error) {
+	if c.isEmpty(name) {
+		return nil, c.emptyError(e.typ.String())
+	} else if v, ok := e.values[e.key(name)]; ok {
+		return v, nil
+	} else if e.typ.Kind() == reflect.String {
+		return nil, e.unknown(name)
+	}
+
+	return e.parseNumber(c, name)
+}
+
+// parseNumber converts the numeric value to the enum type, numbers with fraction or out of range are unknown.
+func (e *enumInfo) parseNumber(c *Converter, value any) (any, error) {
+	v, err := e.convertNumber(c.exactNumbers(), value)
+	if IsCastError(err) || IsOverflowError(err) {
+		return nil, e.unknown(value)
+	}
+	return v, err
+}
+
+// convertNumber converts the numeric value to the enum type.
+func (e *enumInfo) convertNumber(c *Converter, value any) (any, error) {
+	res := reflect.New(e.typ).Elem()
+	switch e.typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := toSigned[int64](c, value)
+		if err != nil {
+			return nil, err
+		} else if res.OverflowInt(v) {
+			return nil, e.unknown(value)
+		}
+		res.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := toUnsigned[uint64](c, value)
+		if err != nil {
+			return nil, err
+		} else if res.OverflowUint(v) {
+			return nil, e.unknown(value)
+		}
+		res.SetUint(v)
+	default:
+		return nil, typeError(e.typ.String())
+	}
+
+	if _, ok := e.names[res.Interface()]; !ok {
+		return nil, e.unknown(value)
+	}
+	return res.Interface(), nil
+}
+
+// unknown returns the casting error of an unknown value listing the allowed names.
+func (e *enumInfo) unknown(value any) error {
+	return fmt.Errorf("%s %s: unknown value %q, allowed values are %s",
+		errorType, e.typ, fmt.Sprint(value), strings.Join(e.allowed, ", "))
+}
@@ -0,0 +1,105 @@
+package gocast_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+type enumStatus int
+
+const (
+	statusInactive enumStatus = iota
+	statusActive
+	statusBanned
+)
+
+type enumLevel string
+
+type enumLabel string
+
+func (l enumLabel) String() string {
+	return string(l)
+}
+
+func init() {
+	gocast.RegisterEnum(map[string]enumStatus{
+		"inactive": statusInactive,
+		"active":   statusActive,
+		"banned":   statusBanned,
+	}, gocast.EnumOptions[enumStatus]{Aliases: map[string]enumStatus{"enabled": statusActive}})
+
+	gocast.RegisterEnum(map[string]enumLevel{"Debug": "debug", "Info": "info"},
+		gocast.EnumOptions[enumLevel]{CaseSensitive: true})
+}
+
+func TestToEnum(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected enumStatus
+	}{
+		{"active", statusActive},
+		{" BANNED ", statusBanned},
+		{"enabled", statusActive},
+		{"0", statusInactive},
+		{2, statusBanned},
+		{uint8(1), statusActive},
+		{[]byte("Inactive"), statusInactive},
+		{enumLabel("banned"), statusBanned},
+		{statusActive, statusActive},
+	}
+
+	for _, test := range tests {
+		if v, err := gocast.ToEnum[enumStatus](test.input); err != nil || v != test.expected {
+			t.Errorf("ToEnum(%v) = %v, %v, expected %v", test.input, v, err, test.expected)
+		}
+	}
+
+	for _, input := range []any{"deleted", 7, -1, enumStatus(9), "1.5"} {
+		_, err := gocast.ToEnum[enumStatus](input)
+		if !gocast.IsCastError(err) || !strings.Contains(err.Error(), "active, banned, inactive") {
+			t.Errorf("ToEnum(%v) error = %v, expected unknown value error", input, err)
+		}
+	}
+
+	if v, err := gocast.ToEnum[enumLevel]("Info"); err != nil || v != "info" {
+		t.Errorf("ToEnum(Info) = %v, %v", v, err)
+	}
+	if _, err := gocast.ToEnum[enumLevel]("info"); !gocast.IsCastError(err) {
+		t.Errorf("ToEnum(info) case-sensitive error = %v, expected cast error", err)
+	}
+
+	if _, err := gocast.ToEnum[enumLabel]("x"); err == nil {
+		t.Errorf("ToEnum() of unregistered type expected error")
+	}
+}
+
+func TestEnumString(t *testing.T) {
+	if v, err := gocast.ToString(statusBanned); err != nil || v != "banned" {
+		t.Errorf("ToString(statusBanned) = %v, %v", v, err)
+	}
+	if _, err := gocast.ToString(enumStatus(9)); !gocast.IsCastError(err) {
+		t.Errorf("ToString(enumStatus(9)) error = %v, expected cast error", err)
+	}
+}
+
+func TestDecodeEnum(t *testing.T) {
+	type User struct {
+		Status enumStatus  `cast:"status"`
+		Levels []enumLevel `cast:"levels"`
+	}
+
+	var user User
+	err := gocast.Decode(map[string]any{"status": "Active", "levels": []string{"Debug", "Info"}}, &user)
+	if err != nil || user.Status != statusActive || len(user.Levels) != 2 || user.Levels[1] != "info" {
+		t.Fatalf("Decode() = %+v, %v", user, err)
+	}
+
+	err = gocast.Decode(map[string]any{"status": "unknown"}, &user)
+	var fe *gocast.FieldError
+	if !gocast.IsCastError(err) || !errors.As(err, &fe) || fe.Path != "status" {
+		t.Errorf("Decode() unknown enum error = %v", err)
+	}
+}
@@ -0,0 +1,233 @@
+package gocast
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// EnvLookup retrieves the value of the environment variable named by the key.
+// The boolean result reports whether the variable is set.
+type EnvLookup func(key string) (string, bool)
+
+// Environment provides typed access to environment variables.
+// Values may contain ${VAR} and ${VAR:-default} references that are expanded on read.
+type Environment interface {
+	// Env returns a Caster for the environment variable.
+	// Unset variables produce a nil Caster, while empty variables produce an empty string.
+	Env(key string) Caster
+
+	// Prefix returns a navigable document of all variables starting with the prefix.
+	// The prefix is removed and keys are lowercased and nested by underscore,
+	// e.g. APP_DB_HOST with APP_ prefix is accessible as "db.host".
+	// When a variable is also a parent of other variables, the nested variables take precedence.
+	Prefix(prefix string) Caster
+
+	// Expand replaces ${VAR} and ${VAR:-default} references in the string.
+	// Default values are used when the variable is unset or empty.
+	Expand(s string) string
+
+	// Bind fills the struct pointed by out from environment variables.
+	// Fields are bound by `env:"KEY"` tag, `default:"value"` tag is used for unset variables
+	// and `required:"true"` tag reports an error for unset variables without default.
+	// Slice fields are split by comma or the `separator` tag.
+	// Untagged struct fields are bound recursively.
+	Bind(out any) error
+}
+
+// NewEnvironment creates a new Environment using the provided lookup function.
+// The environ function returns the "key=value" list used by Prefix, it may be nil.
+func NewEnvironment(lookup EnvLookup, environ func() []string) Environment {
+	if environ == nil {
+		environ = func() []string { return nil }
+	}
+	return envDriver{
+		lookup:  lookup,
+		environ: environ,
+	}
+}
+
+var defaultEnv = NewEnvironment(os.LookupEnv, os.Environ)
+
+// Env returns a Caster for the os environment variable.
+// Unset variables produce a nil Caster, while empty variables produce an empty string.
+func Env(key string) Caster {
+	return defaultEnv.Env(key)
+}
+
+// EnvPrefix returns a navigable document of all os environment variables starting with the prefix.
+func EnvPrefix(prefix string) Caster {
+	return defaultEnv.Prefix(prefix)
+}
+
+// ExpandEnv replaces ${VAR} and ${VAR:-default} references in the string using os environment.
+func ExpandEnv(s string) string {
+	return defaultEnv.Expand(s)
+}
+
+// BindEnv fills the struct pointed by out from os environment variables.
+func BindEnv(out any) error {
+	return defaultEnv.Bind(out)
+}
+
+type envDriver struct {
+	lookup  EnvLookup
+	environ func() []string
+}
+
+func (env envDriver) Env(key string) Caster {
+	if v, ok := env.lookup(key); ok {
+		return NewCaster(env.Expand(v))
+	}
+	return NewCaster(nil)
+}
+
+func (env envDriver) Prefix(prefix string) Caster {
+	values := make(map[string]string)
+	for _, item := range env.environ() {
+		key, value, ok := strings.Cut(item, "=")
+		if !ok || !strings.HasPrefix(key, prefix) || key == prefix {
+			continue
+		}
+		values[key[len(prefix):]] = env.Expand(value)
+	}
+	return NewCaster(nestVariables(values))
+}
+
+// nestVariables nests the variables by their lowercased keys split by underscore.
+// Nested variables take precedence over variables with the same key as their parent.
+func nestVariables(values map[string]string) map[string]any {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := make(map[string]any)
+	for _, key := range keys {
+		insertNested(res, strings.Split(strings.ToLower(key), "_"), values[key])
+	}
+	return res
+}
+
+func (env envDriver) Expand(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] == '$' && i+1 < len(s) && s[i+1] == '{' {
+			if end := closingBrace(s, i+1); end > 0 {
+				sb.WriteString(env.resolve(s[i+2 : end]))
+				i = end + 1
+				continue
+			}
+		}
+		sb.WriteByte(s[i])
+		i++
+	}
+	return sb.String()
+}
+
+// resolve resolves a VAR or VAR:-default expression.
+func (env envDriver) resolve(expr string) string {
+	name, fallback, hasDefault := strings.Cut(expr, ":-")
+	if v, ok := env.lookup(strings.TrimSpace(name)); ok && (v != "" || !hasDefault) {
+		return v
+	}
+
+	if hasDefault {
+		return env.Expand(fallback)
+	}
+	return ""
+}
+
+// closingBrace returns the index of the brace closing the one at open index, or -1.
+func closingBrace(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (env envDriver) Bind(out any) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind target must be a non-nil struct pointer")
+	}
+	return env.bindStruct(rv.Elem())
+}
+
+func (env envDriver) bindStruct(v reflect.Value) error {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Tag.Get("env")
+		if key == "-" {
+			continue
+		}
+
+		// Bind nested structs
+		if key == "" {
+			target := v.Field(i)
+			if target.Kind() == reflect.Struct && !reflect.PointerTo(target.Type()).Implements(textUnmarshalerType) {
+				if err := env.bindStruct(target); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		value, ok := env.lookup(key)
+		if !ok {
+			value, ok = field.Tag.Lookup("default")
+		}
+
+		if !ok {
+			if required, _ := ToBool(field.Tag.Get("required")); required {
+				return fieldError(key, requiredErr())
+			}
+			continue
+		}
+
+		var input any = env.Expand(value)
+		if kind := field.Type.Kind(); (kind == reflect.Slice && field.Type.Elem().Kind() != reflect.Uint8) || kind == reflect.Array {
+			input = splitList(input.(string), field.Tag.Get("separator"))
+		}
+
+		if err := decodeValue(defaultConverter, input, v.Field(i), key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitList splits a delimited string and trims its items.
+// The default separator is comma. Empty strings produce an empty list.
+func splitList(s string, separator string) []string {
+	if separator == "" {
+		separator = ","
+	}
+
+	if strings.TrimSpace(s) == "" {
+		return []string{}
+	}
+
+	res := strings.Split(s, separator)
+	for i := range res {
+		res[i] = strings.TrimSpace(res[i])
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+package gocast_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+func testEnvironment(vars map[string]string) gocast.Environment {
+	return gocast.NewEnvironment(
+		func(key string) (string, bool) {
+			v, ok := vars[key]
+			return v, ok
+		},
+		func() []string {
+			res := make([]string, 0, len(vars))
+			for k, v := range vars {
+				res = append(res, k+"="+v)
+			}
+			return res
+		},
+	)
+}
+
+func TestEnv(t *testing.T) {
+	env := testEnvironment(map[string]string{
+		"PORT":  "8080",
+		"EMPTY": "",
+		"HOST":  "localhost",
+		"URL":   "http://${HOST}:${PORT}/${PATH:-api}",
+	})
+
+	if v := env.Env("PORT").IntSafe(0); v != 8080 {
+		t.Errorf("Env(PORT) = %v, expected 8080", v)
+	}
+
+	if !env.Env("MISSING").IsNil() {
+		t.Error("Env(MISSING) must be nil")
+	}
+
+	if c := env.Env("EMPTY"); c.IsNil() || c.StringSafe("-") != "" {
+		t.Error("Env(EMPTY) must be an empty string")
+	}
+
+	if v := env.Env("URL").StringSafe(""); v != "http://localhost:8080/api" {
+		t.Errorf("Env(URL) = %v", v)
+	}
+}
+
+func TestEnvPrefix(t *testing.T) {
+	env := testEnvironment(map[string]string{
+		"APP_DB_HOST": "db",
+		"APP_DB_PORT": "5432",
+		"APP_DEBUG":   "true",
+		"OTHER":       "x",
+	})
+
+	doc := env.Prefix("APP_")
+	if v := doc.Get("db.port").IntSafe(0); v != 5432 {
+		t.Errorf("Prefix().Get(db.port) = %v, expected 5432", v)
+	}
+
+	if v := doc.Get("debug").BoolSafe(false); !v {
+		t.Errorf("Prefix().Get(debug) = %v, expected true", v)
+	}
+
+	if !doc.Get("other").IsNil() {
+		t.Error("Prefix().Get(other) must be nil")
+	}
+}
+
+func TestBindEnv(t *testing.T) {
+	type Database struct {
+		Host string `env:"DB_HOST" default:"localhost"`
+		Port int    `env:"DB_PORT" required:"true"`
+	}
+
+	type Config struct {
+		Database
+		Debug   bool          `env:"DEBUG"`
+		Tags    []string      `env:"TAGS"`
+		Timeout time.Duration `env:"TIMEOUT" default:"15"`
+		Ignored string        `env:"-"`
+	}
+
+	env := testEnvironment(map[string]string{
+		"DB_PORT": "5432",
+		"DEBUG":   "1",
+		"TAGS":    "a, b,c",
+	})
+
+	var cfg Config
+	if err := env.Bind(&cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Config{
+		Database: Database{Host: "localhost", Port: 5432},
+		Debug:    true,
+		Tags:     []string{"a", "b", "c"},
+		Timeout:  15,
+	}
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Bind() = %+v, expected %+v", cfg, expected)
+	}
+
+	err := testEnvironment(map[string]string{}).Bind(&cfg)
+	if !gocast.IsRequiredError(err) {
+		t.Errorf("Bind() error = %v, expected required error", err)
+	}
+}
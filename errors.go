@@ -1,6 +1,7 @@
 package gocast
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -8,6 +9,8 @@ import (
 const errorNil = "value is nil"
 const errorType = "cannot convert value to type"
 const errorOverflow = "value is out of range for"
+const errorRequired = "value is required"
+const errorLimit = "document exceeds the limit of"
 
 func nilErr() error {
 	return fmt.Errorf(errorNil)
@@ -21,20 +24,105 @@ func overflowError(t string) error {
 	return fmt.Errorf("%s %s", errorOverflow, t)
 }
 
+func requiredErr() error {
+	return fmt.Errorf(errorRequired)
+}
+
+func limitError(limit string) error {
+	return fmt.Errorf("%s %s", errorLimit, limit)
+}
+
+// FieldError describes a conversion error of a nested value or struct field.
+type FieldError struct {
+	// Path is the dot separated path of the field, e.g. "users[0].age".
+	Path string
+	// Err is the underlying conversion error.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors is a list of field errors.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e FieldErrors) Unwrap() []error {
+	res := make([]error, len(e))
+	for i, err := range e {
+		res[i] = err
+	}
+	return res
+}
+
+// fieldError wraps err with the field path. If path is empty err is returned as is.
+func fieldError(path string, err error) error {
+	if err == nil || path == "" {
+		return err
+	}
+
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		return err
+	}
+	return &FieldError{Path: path, Err: err}
+}
+
+// causeOf returns the innermost error of a wrapped error chain.
+func causeOf(err error) error {
+	for err != nil {
+		inner := errors.Unwrap(err)
+		if inner == nil {
+			return err
+		}
+		err = inner
+	}
+	return err
+}
+
 // IsNilError checks if the provided error is a bil error.
 // It returns true if the error is not nil and its nil error.
 func IsNilError(err error) bool {
+	err = causeOf(err)
 	return err != nil && err.Error() == errorNil
 }
 
 // IsCastError checks if the provided error is a casting error.
 // It returns true if the error is not nil and its a casting error.
 func IsCastError(err error) bool {
+	err = causeOf(err)
 	return err != nil && strings.HasPrefix(err.Error(), errorType)
 }
 
 // IsOverflowError checks if the provided error is a overflow error.
 // It returns true if the error is not nil and its a overflow error.
 func IsOverflowError(err error) bool {
-	return err != nil && strings.HasPrefix(err.Error(), errorType)
+	err = causeOf(err)
+	return err != nil && strings.HasPrefix(err.Error(), errorOverflow)
+}
+
+// IsRequiredError checks if the provided error is a required value error.
+// It returns true if the error is not nil and its a required error.
+func IsRequiredError(err error) bool {
+	err = causeOf(err)
+	return err != nil && err.Error() == errorRequired
+}
+
+// IsLimitError checks if the provided error is a document size or depth limit error.
+// It returns true if the error is not nil and its a limit error.
+func IsLimitError(err error) bool {
+	err = causeOf(err)
+	return err != nil && strings.HasPrefix(err.Error(), errorLimit)
 }
@@ -0,0 +1,236 @@
+package gocast
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type flagType interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// flagName is a registered name of a single bit flag.
+type flagName struct {
+	name  string
+	value uint64
+}
+
+// flagInfo is the type-erased registration of a bit flag type.
+type flagInfo struct {
+	typ     reflect.Type
+	values  map[string]uint64
+	flags   []flagName
+	allowed []string
+	mask    uint64
+}
+
+// flagSets holds the registered bit flags by type.
+var flagSets sync.Map
+
+// RegisterFlags registers the names of the bit flags of type T, e.g. {"read": Read, "write": Write}.
+// Names are matched case-insensitively. Names of several bits (e.g. {"all": Read | Write})
+// are accepted by ToFlags and Decode, FormatFlags uses the single bit names only.
+// Registering the type again replaces the previous registration.
+func RegisterFlags[T flagType](names map[string]T) {
+	info := &flagInfo{
+		typ:    reflect.TypeFor[T](),
+		values: make(map[string]uint64, len(names)),
+	}
+
+	for name, value := range names {
+		info.values[strings.ToLower(strings.TrimSpace(name))] = uint64(value)
+		info.allowed = append(info.allowed, name)
+		info.mask |= uint64(value)
+		if bits.OnesCount64(uint64(value)) == 1 {
+			info.flags = append(info.flags, flagName{name: name, value: uint64(value)})
+		}
+	}
+
+	slices.Sort(info.allowed)
+	slices.SortFunc(info.flags, func(a, b flagName) int {
+		if a.value != b.value {
+			return int(bits.TrailingZeros64(a.value)) - int(bits.TrailingZeros64(b.value))
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	info.flags = slices.CompactFunc(info.flags, func(a, b flagName) bool { return a.value == b.value })
+	flagSets.Store(info.typ, info)
+}
+
+// ToFlags casts an interface to the registered bit flag type T.
+// Strings of names delimited by "," or "|" (e.g. "read,write" or "READ|EXEC"),
+// numeric strings, numbers, []string and []bool (bit i is set by item i) are accepted.
+// Unknown names and bits fail with a casting error listing the allowed names.
+func ToFlags[T flagType](value any) (T, error) {
+	return toFlags[T](defaultConverter, value)
+}
+
+// ToFlagsWith casts an interface to the registered bit flag type T using the converter options.
+func ToFlagsWith[T flagType](c *Converter, value any) (T, error) {
+	return toFlags[T](c, value)
+}
+
+// FormatFlags formats the mask as registered flag names delimited by "|" in bit order,
+// e.g. "read|write". Unregistered bits are formatted as hex number and zero as "0".
+func FormatFlags[T flagType](mask T) string {
+	v := uint64(mask)
+	if v == 0 {
+		return "0"
+	}
+
+	var names []string
+	if info, ok := flagsOf(reflect.TypeFor[T]()); ok {
+		for _, flag := range info.flags {
+			if v&flag.value != 0 {
+				names = append(names, flag.name)
+				v &^= flag.value
+			}
+		}
+	}
+
+	if v != 0 {
+		names = append(names, "0x"+strconv.FormatUint(v, 16))
+	}
+	return strings.Join(names, "|")
+}
+
+// FlagsToBools converts the mask to a bool slice, item i reports whether bit i is set.
+// The slice covers the registered flags and the set bits of the mask.
+func FlagsToBools[T flagType](mask T) []bool {
+	all := uint64(mask)
+	if info, ok := flagsOf(reflect.TypeFor[T]()); ok {
+		all |= info.mask
+	}
+
+	res := make([]bool, bits.Len64(all))
+	for i := range res {
+		res[i] = uint64(mask)&(1<<i) != 0
+	}
+	return res
+}
+
+// toFlags casts an interface to the registered bit flag type T using the converter options.
+func toFlags[T flagType](c *Converter, value any) (T, error) {
+	info, ok := flagsOf(reflect.TypeFor[T]())
+	if !ok {
+		return 0, fmt.Errorf("flags %s are not registered", reflect.TypeFor[T]())
+	}
+
+	v, err := info.parse(c, value)
+	return T(v), err
+}
+
+// flagsOf returns the registered bit flags of the type.
+func flagsOf(t reflect.Type) (*flagInfo, bool) {
+	info, ok := flagSets.Load(t)
+	if !ok {
+		return nil, false
+	}
+	return info.(*flagInfo), true
+}
+
+// parse converts the value to a mask of the registered flags, numbers with fraction are rejected.
+func (f *flagInfo) parse(c *Converter, value any) (uint64, error) {
+	c = c.exactNumbers()
+	value = valueOf(value)
+	if value == nil {
+		return 0, c.nilError(f.typ.String())
+	} else if rv := reflect.ValueOf(value); rv.Type() == f.typ {
+		return f.check(rv.Uint())
+	}
+
+	switch val := value.(type) {
+	case string:
+		return f.parseString(c, val)
+	case []byte:
+		return f.parseString(c, string(val))
+	case json.Number:
+		return f.parseString(c, string(val))
+	case []string:
+		return f.parseNames(c, val)
+	case []any:
+		var res uint64
+		for _, item := range val {
+			v, err := f.parse(c, item)
+			if err != nil {
+				return 0, err
+			}
+			res |= v
+		}
+		return res, nil
+	case []bool:
+		var res uint64
+		for i, set := range val {
+			if set && i >= 64 {
+				return 0, overflowError(f.typ.String())
+			} else if set {
+				res |= 1 << i
+			}
+		}
+		return f.check(res)
+	default:
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return 0, err
+			}
+			return f.parseString(c, text)
+		}
+
+		v, err := toUnsigned[uint64](c, val)
+		if err != nil {
+			return 0, err
+		}
+		return f.check(v)
+	}
+}
+
+// parseString parses the names delimited by "," or "|".
+func (f *flagInfo) parseString(c *Converter, s string) (uint64, error) {
+	if c.isEmpty(s) {
+		return 0, c.emptyError(f.typ.String())
+	}
+	return f.parseNames(c, strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '|' }))
+}
+
+// parseNames parses the flag names or numbers.
+func (f *flagInfo) parseNames(c *Converter, names []string) (uint64, error) {
+	var res uint64
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		} else if v, ok := f.values[strings.ToLower(name)]; ok {
+			res |= v
+			continue
+		}
+
+		v, err := parseUnsigned[uint64](c, name)
+		if IsCastError(err) {
+			return 0, f.unknown(name)
+		} else if err != nil {
+			return 0, err
+		}
+		res |= v
+	}
+	return f.check(res)
+}
+
+// check returns the mask or an error if it has unregistered bits.
+func (f *flagInfo) check(v uint64) (uint64, error) {
+	if unknown := v &^ f.mask; unknown != 0 {
+		return 0, f.unknown("0x" + strconv.FormatUint(unknown, 16))
+	}
+	return v, nil
+}
+
+// unknown returns the casting error of an unknown flag listing the allowed names.
+func (f *flagInfo) unknown(name string) error {
+	return fmt.Errorf("%s %s: unknown flag %q, allowed flags are %s",
+		errorType, f.typ, name, strings.Join(f.allowed, ", "))
+}
@@ -0,0 +1,98 @@
+package gocast_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+type flagPerm uint8
+
+const (
+	permRead flagPerm = 1 << iota
+	permWrite
+	permExec
+)
+
+func init() {
+	gocast.RegisterFlags(map[string]flagPerm{
+		"read":  permRead,
+		"write": permWrite,
+		"exec":  permExec,
+		"all":   permRead | permWrite | permExec,
+	})
+}
+
+func TestToFlags(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected flagPerm
+	}{
+		{"read,write", permRead | permWrite},
+		{"READ|EXEC", permRead | permExec},
+		{" write , exec ", permWrite | permExec},
+		{"all", permRead | permWrite | permExec},
+		{"3", permRead | permWrite},
+		{"0b101", permRead | permExec},
+		{"read|4", permRead | permExec},
+		{"", 0},
+		{5, permRead | permExec},
+		{[]string{"write", "exec"}, permWrite | permExec},
+		{[]any{"read", 4}, permRead | permExec},
+		{[]bool{true, false, true}, permRead | permExec},
+		{permWrite, permWrite},
+	}
+
+	for _, test := range tests {
+		if v, err := gocast.ToFlags[flagPerm](test.input); err != nil || v != test.expected {
+			t.Errorf("ToFlags(%v) = %v, %v, expected %v", test.input, v, err, test.expected)
+		}
+	}
+
+	for _, input := range []any{"read,delete", 8, []bool{false, false, false, true}, flagPerm(16), "1.5"} {
+		if _, err := gocast.ToFlags[flagPerm](input); !gocast.IsCastError(err) {
+			t.Errorf("ToFlags(%v) error = %v, expected cast error", input, err)
+		}
+	}
+
+	if _, err := gocast.ToFlags[flagPerm](-1); !gocast.IsOverflowError(err) {
+		t.Errorf("ToFlags(-1) error = %v, expected overflow error", err)
+	}
+}
+
+func TestFormatFlags(t *testing.T) {
+	tests := []struct {
+		mask     flagPerm
+		expected string
+	}{
+		{permRead | permWrite, "read|write"},
+		{permExec | permRead, "read|exec"},
+		{permRead | 64, "read|0x40"},
+		{0, "0"},
+	}
+
+	for _, test := range tests {
+		if v := gocast.FormatFlags(test.mask); v != test.expected {
+			t.Errorf("FormatFlags(%d) = %q, expected %q", test.mask, v, test.expected)
+		}
+	}
+
+	if v := gocast.FlagsToBools(permRead | permExec); !reflect.DeepEqual(v, []bool{true, false, true}) {
+		t.Errorf("FlagsToBools() = %v", v)
+	}
+}
+
+func TestDecodeFlags(t *testing.T) {
+	type File struct {
+		Perm flagPerm `cast:"perm"`
+	}
+
+	var file File
+	if err := gocast.Decode(map[string]any{"perm": "read|write"}, &file); err != nil || file.Perm != permRead|permWrite {
+		t.Errorf("Decode() = %+v, %v", file, err)
+	}
+	if err := gocast.Decode(map[string]any{"perm": "root"}, &file); !gocast.IsCastError(err) {
+		t.Errorf("Decode() unknown flag error = %v, expected cast error", err)
+	}
+}
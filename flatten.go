@@ -0,0 +1,290 @@
+package gocast
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// KeyCase defines the case transformation of keys.
+type KeyCase int
+
+const (
+	// KeyCaseNone keeps keys unchanged.
+	KeyCaseNone KeyCase = iota
+	// KeyCaseLower converts keys to lower case, e.g. "db.host".
+	KeyCaseLower
+	// KeyCaseUpper converts keys to upper case, e.g. "DB_HOST".
+	KeyCaseUpper
+	// KeyCaseSnake converts keys to snake case, e.g. "HTTPPort" to "http_port".
+	KeyCaseSnake
+	// KeyCaseCamel converts keys to camel case, e.g. "HTTPPort" to "httpPort".
+	KeyCaseCamel
+	// KeyCaseKebab converts keys to kebab case, e.g. "HTTPPort" to "http-port".
+	KeyCaseKebab
+)
+
+// apply transforms the key using the case.
+func (k KeyCase) apply(key string) string {
+	switch k {
+	case KeyCaseLower:
+		return strings.ToLower(key)
+	case KeyCaseUpper:
+		return strings.ToUpper(key)
+	case KeyCaseSnake:
+		return strings.Join(splitWords(key), "_")
+	case KeyCaseKebab:
+		return strings.Join(splitWords(key), "-")
+	case KeyCaseCamel:
+		words := splitWords(key)
+		for i := 1; i < len(words); i++ {
+			runes := []rune(words[i])
+			runes[0] = unicode.ToUpper(runes[0])
+			words[i] = string(runes)
+		}
+		return strings.Join(words, "")
+	default:
+		return key
+	}
+}
+
+// splitWords splits the key into lowercase words by separators and case changes,
+// e.g. "HTTPServer_port" into "http", "server" and "port".
+func splitWords(key string) []string {
+	runes := []rune(key)
+	words := make([]string, 0)
+	start := 0
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]) {
+			if start < i {
+				words = append(words, strings.ToLower(string(runes[start:i])))
+			}
+			start = i + 1
+			continue
+		}
+
+		boundary := i == len(runes)
+		if !boundary && i > start && unicode.IsUpper(runes[i]) {
+			prev := runes[i-1]
+			next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			boundary = !unicode.IsUpper(prev) || next
+		}
+
+		if boundary && start < i {
+			words = append(words, strings.ToLower(string(runes[start:i])))
+			start = i
+		}
+	}
+	return words
+}
+
+// IndexStyle defines the notation of slice indexes in flat keys.
+type IndexStyle int
+
+const (
+	// IndexDot writes indexes as segments, e.g. "hosts.0".
+	IndexDot IndexStyle = iota
+	// IndexBracket writes indexes in brackets, e.g. "hosts[0]".
+	IndexBracket
+)
+
+// FlattenOptions configures Flatten and Unflatten.
+type FlattenOptions struct {
+	// Separator joins the key segments, defaults to ".".
+	Separator string
+	// KeyCase transforms the key segments.
+	KeyCase KeyCase
+	// IndexStyle is the notation of slice indexes used by Flatten.
+	// Unflatten accepts both notations.
+	IndexStyle IndexStyle
+	// Stringify converts the flattened values to strings using ToString.
+	Stringify bool
+}
+
+// separator returns the separator or the default one.
+func (o FlattenOptions) separator() string {
+	if o.Separator == "" {
+		return "."
+	}
+	return o.Separator
+}
+
+// Flatten converts nested maps and slices into a flat map with joined keys,
+// e.g. {"db": {"hosts": ["x"]}} into {"db.hosts.0": "x"}.
+// Empty maps and slices are kept as values (or empty strings if stringified).
+func Flatten(value any, opts FlattenOptions) (map[string]any, error) {
+	res := make(map[string]any)
+	switch root := containerOf(valueOf(value)).(type) {
+	case map[string]any, []any:
+		if err := flattenInto(res, "", root, opts); err != nil {
+			return nil, err
+		}
+		return res, nil
+	default:
+		return nil, typeError("map[string]any")
+	}
+}
+
+// flattenInto adds the flattened value to the result with the key prefix.
+func flattenInto(res map[string]any, prefix string, value any, opts FlattenOptions) error {
+	switch val := containerOf(value).(type) {
+	case map[string]any:
+		if len(val) > 0 {
+			for key, item := range val {
+				if err := flattenInto(res, flatKey(prefix, opts.KeyCase.apply(key), opts), item, opts); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []any:
+		if len(val) > 0 {
+			for i, item := range val {
+				if err := flattenInto(res, flatIndex(prefix, i, opts), item, opts); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	if !opts.Stringify {
+		res[prefix] = value
+		return nil
+	}
+
+	// Empty containers
+	switch containerOf(value).(type) {
+	case map[string]any, []any:
+		res[prefix] = ""
+		return nil
+	}
+
+	s, err := ToString(value)
+	if err != nil && !IsNilError(err) {
+		return fieldError(prefix, err)
+	}
+	res[prefix] = s
+	return nil
+}
+
+// flatKey appends a key segment to the prefix.
+func flatKey(prefix, key string, opts FlattenOptions) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + opts.separator() + key
+}
+
+// flatIndex appends an index segment to the prefix.
+func flatIndex(prefix string, index int, opts FlattenOptions) string {
+	if opts.IndexStyle == IndexBracket {
+		return prefix + "[" + strconv.Itoa(index) + "]"
+	}
+	return flatKey(prefix, strconv.Itoa(index), opts)
+}
+
+// Unflatten converts a flat map with joined keys into nested maps,
+// e.g. {"db.hosts.0": "x"} or {"DB_HOSTS_0": "x"} into {"db": {"hosts": ["x"]}}.
+// Numeric segments (e.g. "hosts.0" or "hosts[0]") forming a contiguous range
+// starting from zero create slices, map values are kept as is.
+// Conflicting keys, such as "a" and "a.b", fail.
+func Unflatten(m map[string]any, opts FlattenOptions) (map[string]any, error) {
+	res := make(flatNode)
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		segments := unflattenKey(key, opts)
+		if len(segments) == 0 {
+			continue
+		}
+
+		value := cloneValue(m[key])
+		if opts.Stringify {
+			s, err := ToString(value)
+			if err != nil && !IsNilError(err) {
+				return nil, fieldError(key, err)
+			}
+			value = s
+		}
+
+		if err := insertFlat(res, segments, value); err != nil {
+			return nil, fieldError(key, err)
+		}
+	}
+	return res.listify(), nil
+}
+
+// unflattenKey splits a flat key into its segments.
+func unflattenKey(key string, opts FlattenOptions) []string {
+	sep := opts.separator()
+	key = strings.NewReplacer("[", sep, "]", "").Replace(key)
+
+	res := make([]string, 0)
+	for _, segment := range strings.Split(key, sep) {
+		if segment != "" {
+			res = append(res, opts.KeyCase.apply(segment))
+		}
+	}
+	return res
+}
+
+// flatNode is an intermediate map created by Unflatten, other maps are leaf values.
+type flatNode map[string]any
+
+// insertFlat inserts the value at the segments, creating intermediate nodes.
+// Existing values are never replaced, leaf values can not be nested into.
+func insertFlat(node flatNode, segments []string, value any) error {
+	for _, segment := range segments[:len(segments)-1] {
+		next, exists := node[segment]
+		if !exists {
+			next = make(flatNode)
+			node[segment] = next
+		}
+
+		nested, ok := next.(flatNode)
+		if !ok {
+			return fmt.Errorf("conflicting key %q", segment)
+		}
+		node = nested
+	}
+
+	last := segments[len(segments)-1]
+	if _, exists := node[last]; exists {
+		return fmt.Errorf("conflicting key %q", last)
+	}
+	node[last] = value
+	return nil
+}
+
+// listify converts the node to a map, nested nodes with keys from "0" to "n-1" are converted into slices.
+func (n flatNode) listify() map[string]any {
+	res := make(map[string]any, len(n))
+	for key, item := range n {
+		if nested, ok := item.(flatNode); ok {
+			res[key] = nested.list()
+		} else {
+			res[key] = item
+		}
+	}
+	return res
+}
+
+// list converts the node to a slice if its keys are from "0" to "n-1", or to a map otherwise.
+func (n flatNode) list() any {
+	m := n.listify()
+	if len(m) == 0 {
+		return m
+	}
+
+	res := make([]any, len(m))
+	for key, item := range m {
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(m) || strconv.Itoa(i) != key {
+			return m
+		}
+		res[i] = item
+	}
+	return res
+}
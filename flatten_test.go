@@ -0,0 +1,124 @@
+package gocast_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestFlatten(t *testing.T) {
+	input := map[string]any{
+		"db": map[string]any{
+			"host":  "x",
+			"port":  5432,
+			"hosts": []string{"a", "b"},
+		},
+		"debug": true,
+		"empty": []any{},
+	}
+
+	tests := []struct {
+		opts     gocast.FlattenOptions
+		expected map[string]any
+	}{
+		{gocast.FlattenOptions{}, map[string]any{
+			"db.host": "x", "db.port": 5432, "db.hosts.0": "a", "db.hosts.1": "b", "debug": true, "empty": []any{},
+		}},
+		{gocast.FlattenOptions{IndexStyle: gocast.IndexBracket, Stringify: true}, map[string]any{
+			"db.host": "x", "db.port": "5432", "db.hosts[0]": "a", "db.hosts[1]": "b", "debug": "true", "empty": "",
+		}},
+		{gocast.FlattenOptions{Separator: "_", KeyCase: gocast.KeyCaseUpper, Stringify: true}, map[string]any{
+			"DB_HOST": "x", "DB_PORT": "5432", "DB_HOSTS_0": "a", "DB_HOSTS_1": "b", "DEBUG": "true", "EMPTY": "",
+		}},
+	}
+
+	for _, test := range tests {
+		result, err := gocast.Flatten(input, test.opts)
+		if err != nil {
+			t.Errorf("Flatten(%+v) error = %v", test.opts, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Flatten(%+v) = %v, expected %v", test.opts, result, test.expected)
+		}
+	}
+
+	if _, err := gocast.Flatten("scalar", gocast.FlattenOptions{}); !gocast.IsCastError(err) {
+		t.Errorf("Flatten(scalar) error = %v, expected cast error", err)
+	}
+
+	if _, err := gocast.Flatten(map[string]any{"a": struct{}{}}, gocast.FlattenOptions{Stringify: true}); err == nil {
+		t.Errorf("Flatten() with unstringifiable value expected error")
+	}
+}
+
+func TestUnflatten(t *testing.T) {
+	tests := []struct {
+		input    map[string]any
+		opts     gocast.FlattenOptions
+		expected map[string]any
+	}{
+		{
+			map[string]any{"db.host": "x", "db.hosts.1": "b", "db.hosts[0]": "a"},
+			gocast.FlattenOptions{},
+			map[string]any{"db": map[string]any{"host": "x", "hosts": []any{"a", "b"}}},
+		},
+		{
+			map[string]any{"DB_HOST": "x", "DB_PORT": 5432, "USERS_0_NAME": "admin"},
+			gocast.FlattenOptions{Separator: "_", KeyCase: gocast.KeyCaseLower, Stringify: true},
+			map[string]any{
+				"db":    map[string]any{"host": "x", "port": "5432"},
+				"users": []any{map[string]any{"name": "admin"}},
+			},
+		},
+		{
+			map[string]any{"ports.8080": "http", "ids.1": "x"},
+			gocast.FlattenOptions{},
+			map[string]any{"ports": map[string]any{"8080": "http"}, "ids": map[string]any{"1": "x"}},
+		},
+		{
+			map[string]any{"pair": map[string]any{"0": "x", "1": "y"}, "list.0": []any{"a"}},
+			gocast.FlattenOptions{},
+			map[string]any{"pair": map[string]any{"0": "x", "1": "y"}, "list": []any{[]any{"a"}}},
+		},
+	}
+
+	for _, test := range tests {
+		result, err := gocast.Unflatten(test.input, test.opts)
+		if err != nil {
+			t.Errorf("Unflatten(%v) error = %v", test.input, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Unflatten(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+
+	if _, err := gocast.Unflatten(map[string]any{"a": 1, "a.b": 2}, gocast.FlattenOptions{}); err == nil {
+		t.Errorf("Unflatten() with conflicting keys expected error")
+	}
+	if _, err := gocast.Unflatten(map[string]any{"a": map[string]any{"b": 1}, "a.c": 2}, gocast.FlattenOptions{}); err == nil {
+		t.Errorf("Unflatten() with nested key into a leaf map expected error")
+	}
+}
+
+func TestFlattenRoundTrip(t *testing.T) {
+	input := map[string]any{
+		"a": map[string]any{"b": []any{1, map[string]any{"c": "d"}}},
+		"e": "f",
+	}
+
+	opts := gocast.FlattenOptions{Separator: "__", IndexStyle: gocast.IndexBracket}
+	flat, err := gocast.Flatten(input, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := gocast.Unflatten(flat, opts)
+	if err != nil || !reflect.DeepEqual(result, input) {
+		t.Errorf("Unflatten(Flatten()) = %v, %v, expected %v", result, err, input)
+	}
+}
@@ -1,17 +1,112 @@
 package gocast
 
 import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"html/template"
+	"math/big"
 	"reflect"
 	"strconv"
 )
 
 // ToBool casts an interface to a bool type.
 func ToBool(value interface{}) (bool, error) {
+	return toBool(defaultConverter, value)
+}
+
+// ToSigned casts an interface to a signed integer type.
+func ToSigned[T int | int8 | int16 | int32 | int64](value interface{}) (T, error) {
+	return toSigned[T](defaultConverter, value)
+}
+
+// ToUnsigned casts an interface to a unsigned integer type.
+func ToUnsigned[T uint | uint8 | uint16 | uint32 | uint64](value interface{}) (T, error) {
+	return toUnsigned[T](defaultConverter, value)
+}
+
+// ToFloat casts an interface to a float type.
+func ToFloat[T float32 | float64](value interface{}) (T, error) {
+	return toFloat[T](defaultConverter, value)
+}
+
+// ToString casts an interface to a string type.
+func ToString(value interface{}) (string, error) {
+	return toString(defaultConverter, value)
+}
+
+// ToText casts an interface to a encoding.TextUnmarshaler type.
+// The value is converted to string using ToString and then unmarshaled into T.
+// If T is a pointer type, a new value is allocated.
+func ToText[T encoding.TextUnmarshaler](value interface{}) (T, error) {
+	return toText[T](defaultConverter, value)
+}
+
+// ToTextWith casts an interface to a encoding.TextUnmarshaler type using the converter options.
+func ToTextWith[T encoding.TextUnmarshaler](c *Converter, value any) (T, error) {
+	return toText[T](c, value)
+}
+
+// toText casts an interface to a encoding.TextUnmarshaler type using the converter options.
+func toText[T encoding.TextUnmarshaler](c *Converter, value any) (T, error) {
+	var res T
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+
+	text, err := toString(c, value)
+	if err != nil {
+		return res, err
+	}
+
+	if typ := reflect.TypeOf((*T)(nil)).Elem(); typ.Kind() == reflect.Ptr {
+		res = reflect.New(typ.Elem()).Interface().(T)
+	}
+
+	if err := res.UnmarshalText([]byte(text)); err != nil {
+		var zero T
+		return zero, typeError(typeName[T]())
+	}
+	return res, nil
+}
+
+// ToSlice casts an interface{} to a []interface{} type.
+func ToSlice(value interface{}) ([]interface{}, error) {
+	return toSlice(defaultConverter, value)
+}
+
+// ToBoolSlice casts an interface to a []bool type.
+func ToBoolSlice(i interface{}) ([]bool, error) {
+	return toBoolSlice(defaultConverter, i)
+}
+
+// ToSignedSlice casts an interface to a signed integer slice type.
+func ToSignedSlice[T int | int8 | int16 | int32 | int64](i interface{}) ([]T, error) {
+	return toSignedSlice[T](defaultConverter, i)
+}
+
+// ToUnsignedSlice casts an interface to a unsigned integer slice type.
+func ToUnsignedSlice[T uint | uint8 | uint16 | uint32 | uint64](i interface{}) ([]T, error) {
+	return toUnsignedSlice[T](defaultConverter, i)
+}
+
+// ToFloatSlice casts an interface to a float slice type.
+func ToFloatSlice[T float32 | float64](i interface{}) ([]T, error) {
+	return toFloatSlice[T](defaultConverter, i)
+}
+
+// ToStringSlice casts an interface to a []string type.
+func ToStringSlice(i interface{}) ([]string, error) {
+	return toStringSlice(defaultConverter, i)
+}
+
+// toBool casts an interface to a bool type using the converter options.
+func toBool(c *Converter, value any) (bool, error) {
 	value = valueOf(value)
 	switch val := value.(type) {
 	case nil:
-		return false, nilErr()
+		return false, c.nilError("bool")
 	case BoolErrorProvider:
 		return val.Bool()
 	case BoolProvider:
@@ -43,497 +138,240 @@ func ToBool(value interface{}) (bool, error) {
 	case float64:
 		return val != 0, nil
 	case string:
-		v, err := strconv.ParseBool(val)
+		return c.parseBool(val)
+	case []byte:
+		return toBool(c, string(val))
+	case json.Number:
+		f, err := parseFloat[float64](c, string(val))
 		if err != nil {
-			return false, typeError("bool")
+			return false, err
 		}
-		return v, nil
-	default:
-		v, err := strconv.ParseBool(fmt.Sprintf("%v", value))
+		return f != 0, nil
+	case driver.Valuer:
+		v, err := val.Value()
 		if err != nil {
-			return false, typeError("bool")
+			return false, err
 		}
-		return v, nil
+		return toBool(c, v)
+	default:
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return false, err
+			}
+			return toBool(c, text)
+		}
+
+		return c.parseBool(fmt.Sprintf("%v", value))
 	}
 }
 
-// ToSigned casts an interface to a signed integer type.
-func ToSigned[T int | int8 | int16 | int32 | int64](value interface{}) (T, error) {
+// toSigned casts an interface to a signed integer type using the converter options.
+func toSigned[T signedType](c *Converter, value any) (T, error) {
 	value = valueOf(value)
-	msg := typeError(typeName[T]())
-	ove := overflowError(typeName[T]())
 
 	// Check provider
-	if isImplementsOf[T, int]() {
-		switch val := value.(type) {
-		case IntErrorProvider:
-			v, err := val.Int()
-			return T(v), err
-		case IntProvider:
-			return T(val.Int()), nil
-		}
-	} else if isImplementsOf[T, int8]() {
-		switch val := value.(type) {
-		case Int8ErrorProvider:
-			v, err := val.Int8()
-			return T(v), err
-		case Int8Provider:
-			return T(val.Int8()), nil
-		}
-	} else if isImplementsOf[T, int16]() {
-		switch val := value.(type) {
-		case Int16ErrorProvider:
-			v, err := val.Int16()
-			return T(v), err
-		case Int16Provider:
-			return T(val.Int16()), nil
-		}
-	} else if isImplementsOf[T, int32]() {
-		switch val := value.(type) {
-		case Int32ErrorProvider:
-			v, err := val.Int32()
-			return T(v), err
-		case Int32Provider:
-			return T(val.Int32()), nil
-		}
-	} else if isImplementsOf[T, int64]() {
-		switch val := value.(type) {
-		case Int64ErrorProvider:
-			v, err := val.Int64()
-			return T(v), err
-		case Int64Provider:
-			return T(val.Int64()), nil
-		}
+	if v, ok, err := signedProviderOf[T](value); ok {
+		return v, err
 	}
 
 	// Cast
 	switch val := value.(type) {
 	case nil:
-		return 0, nilErr()
+		return 0, c.nilError(typeName[T]())
 	case bool:
 		if val {
 			return 1, nil
 		}
 		return 0, nil
 	case int:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromInt[T](c, val)
 	case int8:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromInt[T](c, val)
 	case int16:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromInt[T](c, val)
 	case int32:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromInt[T](c, val)
 	case int64:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromInt[T](c, val)
 	case uint:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromUint[T](c, val)
 	case uint8:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromUint[T](c, val)
 	case uint16:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromUint[T](c, val)
 	case uint32:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromUint[T](c, val)
 	case uint64:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromUint[T](c, val)
 	case float32:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromFloat[T](c, val)
 	case float64:
-		if !intInRange[T](int64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return signedFromFloat[T](c, val)
 	case string:
-		i, err := strconv.ParseInt(val, 0, 0)
-		if !intInRange[T](int64(i)) {
-			return 0, ove
-		} else if err == nil {
-			return T(i), nil
-		}
-
-		f, err := strconv.ParseFloat(val, 64)
-		if !intInRange[T](int64(f)) {
-			return 0, ove
-		} else if err == nil {
-			return T(f), nil
+		return parseSigned[T](c, val)
+	case []byte:
+		return parseSigned[T](c, string(val))
+	case json.Number:
+		return parseSigned[T](c, string(val))
+	case driver.Valuer:
+		v, err := val.Value()
+		if err != nil {
+			return 0, err
 		}
-
-		return 0, msg
+		return toSigned[T](c, v)
 	default:
-		i, err := strconv.ParseInt(fmt.Sprintf("%v", val), 0, 0)
-		if !intInRange[T](int64(i)) {
-			return 0, ove
-		} else if err == nil {
-			return T(i), nil
-		}
-
-		f, err := strconv.ParseFloat(fmt.Sprintf("%v", val), 64)
-		if !intInRange[T](int64(f)) {
-			return 0, ove
-		} else if err == nil {
-			return T(f), nil
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return 0, err
+			}
+			return parseSigned[T](c, text)
 		}
-
-		return 0, msg
+		return parseSigned[T](c, fmt.Sprintf("%v", val))
 	}
 }
 
-// ToUnsigned casts an interface to a unsigned integer type.
-func ToUnsigned[T uint | uint8 | uint16 | uint32 | uint64](value interface{}) (T, error) {
+// toUnsigned casts an interface to a unsigned integer type using the converter options.
+func toUnsigned[T unsignedType](c *Converter, value any) (T, error) {
 	value = valueOf(value)
-	msg := typeError(typeName[T]())
-	ove := overflowError(typeName[T]())
 
 	// Check provider
-	if isImplementsOf[T, uint]() {
-		switch val := value.(type) {
-		case UintErrorProvider:
-			v, err := val.Uint()
-			return T(v), err
-		case UintProvider:
-			return T(val.Uint()), nil
-		}
-	} else if isImplementsOf[T, uint8]() {
-		switch val := value.(type) {
-		case Uint8ErrorProvider:
-			v, err := val.Uint8()
-			return T(v), err
-		case Uint8Provider:
-			return T(val.Uint8()), nil
-		}
-	} else if isImplementsOf[T, uint16]() {
-		switch val := value.(type) {
-		case Uint16ErrorProvider:
-			v, err := val.Uint16()
-			return T(v), err
-		case Uint16Provider:
-			return T(val.Uint16()), nil
-		}
-	} else if isImplementsOf[T, uint32]() {
-		switch val := value.(type) {
-		case Uint32ErrorProvider:
-			v, err := val.Uint32()
-			return T(v), err
-		case Uint32Provider:
-			return T(val.Uint32()), nil
-		}
-	} else if isImplementsOf[T, uint64]() {
-		switch val := value.(type) {
-		case Uint64ErrorProvider:
-			v, err := val.Uint64()
-			return T(v), err
-		case Uint64Provider:
-			return T(val.Uint64()), nil
-		}
+	if v, ok, err := unsignedProviderOf[T](value); ok {
+		return v, err
 	}
 
 	// Cast
 	switch val := value.(type) {
 	case nil:
-		return 0, nilErr()
+		return 0, c.nilError(typeName[T]())
 	case bool:
 		if val {
 			return 1, nil
 		}
 		return 0, nil
 	case int:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromInt[T](c, val)
 	case int8:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromInt[T](c, val)
 	case int16:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromInt[T](c, val)
 	case int32:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromInt[T](c, val)
 	case int64:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromInt[T](c, val)
 	case uint:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromUint[T](c, val)
 	case uint8:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromUint[T](c, val)
 	case uint16:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromUint[T](c, val)
 	case uint32:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromUint[T](c, val)
 	case uint64:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromUint[T](c, val)
 	case float32:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromFloat[T](c, val)
 	case float64:
-		if !uintInRange[T](int64(val), uint64(val)) {
-			return 0, ove
-		} else {
-			return T(val), nil
-		}
+		return unsignedFromFloat[T](c, val)
 	case string:
-		i, err := strconv.ParseInt(val, 0, 0)
-		if !uintInRange[T](int64(i), uint64(i)) {
-			return 0, ove
-		} else if err == nil {
-			return T(i), nil
-		}
-
-		f, err := strconv.ParseFloat(val, 64)
-		if !uintInRange[T](int64(f), uint64(f)) {
-			return 0, ove
-		} else if err == nil {
-			return T(f), nil
+		return parseUnsigned[T](c, val)
+	case []byte:
+		return parseUnsigned[T](c, string(val))
+	case json.Number:
+		return parseUnsigned[T](c, string(val))
+	case driver.Valuer:
+		v, err := val.Value()
+		if err != nil {
+			return 0, err
 		}
-
-		return 0, msg
+		return toUnsigned[T](c, v)
 	default:
-		i, err := strconv.ParseInt(fmt.Sprintf("%v", val), 0, 0)
-		if !uintInRange[T](int64(i), uint64(i)) {
-			return 0, ove
-		} else if err == nil {
-			return T(i), nil
-		}
-
-		f, err := strconv.ParseFloat(fmt.Sprintf("%v", val), 64)
-		if !uintInRange[T](int64(f), uint64(f)) {
-			return 0, ove
-		} else if err == nil {
-			return T(f), nil
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return 0, err
+			}
+			return parseUnsigned[T](c, text)
 		}
-
-		return 0, msg
+		return parseUnsigned[T](c, fmt.Sprintf("%v", val))
 	}
 }
 
-// ToFloat casts an interface to a float type.
-func ToFloat[T float32 | float64](value interface{}) (T, error) {
+// toFloat casts an interface to a float type using the converter options.
+func toFloat[T floatType](c *Converter, value any) (T, error) {
 	value = valueOf(value)
-	msg := typeError(typeName[T]())
-	rng := typeError(typeName[T]())
 
 	// Check provider
-	if isImplementsOf[T, float32]() {
-		switch val := value.(type) {
-		case Float32ErrorProvider:
-			v, err := val.Float32()
-			return T(v), err
-		case Float32Provider:
-			return T(val.Float32()), nil
-		}
-	} else if isImplementsOf[T, float64]() {
-		switch val := value.(type) {
-		case Float64ErrorProvider:
-			v, err := val.Float64()
-			return T(v), err
-		case Float64Provider:
-			return T(val.Float64()), nil
-		}
+	if v, ok, err := floatProviderOf[T](value); ok {
+		return v, err
 	}
 
 	// Cast
 	switch val := value.(type) {
 	case nil:
-		return 0, nilErr()
+		return 0, c.nilError(typeName[T]())
 	case bool:
 		if val {
 			return 1, nil
 		}
 		return 0, nil
 	case int:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromInt[T](c, val)
 	case int8:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromInt[T](c, val)
 	case int16:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromInt[T](c, val)
 	case int32:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromInt[T](c, val)
 	case int64:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromInt[T](c, val)
 	case uint:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromUint[T](c, val)
 	case uint8:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromUint[T](c, val)
 	case uint16:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromUint[T](c, val)
 	case uint32:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromUint[T](c, val)
 	case uint64:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromUint[T](c, val)
 	case float32:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromFloat[T](c, val)
 	case float64:
-		if !floatInRange[T](float64(val)) {
-			return 0, rng
-		} else {
-			return T(val), nil
-		}
+		return floatFromFloat[T](c, val)
 	case string:
-		f, err := strconv.ParseFloat(val, 64)
-		if !floatInRange[T](float64(f)) {
-			return 0, rng
-		} else if err == nil {
-			return T(f), nil
-		}
-
-		i, err := strconv.ParseInt(val, 0, 0)
-		if !floatInRange[T](float64(i)) {
-			return 0, rng
-		} else if err == nil {
-			return T(i), nil
+		return parseFloat[T](c, val)
+	case []byte:
+		return parseFloat[T](c, string(val))
+	case json.Number:
+		return parseFloat[T](c, string(val))
+	case driver.Valuer:
+		v, err := val.Value()
+		if err != nil {
+			return 0, err
 		}
-
-		return 0, msg
+		return toFloat[T](c, v)
 	default:
-		f, err := strconv.ParseFloat(fmt.Sprintf("%v", val), 64)
-		if !floatInRange[T](float64(f)) {
-			return 0, rng
-		} else if err == nil {
-			return T(f), nil
-		}
-
-		i, err := strconv.ParseInt(fmt.Sprintf("%v", val), 0, 0)
-		if !floatInRange[T](float64(i)) {
-			return 0, rng
-		} else if err == nil {
-			return T(i), nil
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return 0, err
+			}
+			return parseFloat[T](c, text)
 		}
-
-		return 0, msg
+		return parseFloat[T](c, fmt.Sprintf("%v", val))
 	}
 }
 
-// ToString casts an interface to a string type.
-func ToString(value interface{}) (string, error) {
+// toString casts an interface to a string type using the converter options.
+func toString(c *Converter, value any) (string, error) {
 	value = valueOf(value)
 	switch val := value.(type) {
 	case nil:
-		return "", nilErr()
+		return "", c.nilError("string")
+	case optionalGetter:
+		v, ok := val.getOptional()
+		if !ok {
+			return "", c.nilError("string")
+		}
+		return toString(c, v)
 	case StringErrorProvider:
 		return val.String()
 	case StringProvider:
@@ -541,38 +379,74 @@ func ToString(value interface{}) (string, error) {
 	case bool:
 		return strconv.FormatBool(val), nil
 	case int:
-		return strconv.FormatInt(int64(val), 10), nil
+		return formatInt(c, val)
 	case int8:
-		return strconv.FormatInt(int64(val), 10), nil
+		return formatInt(c, val)
 	case int16:
-		return strconv.FormatInt(int64(val), 10), nil
+		return formatInt(c, val)
 	case int32:
-		return strconv.FormatInt(int64(val), 10), nil
+		return formatInt(c, val)
 	case int64:
-		return strconv.FormatInt(val, 10), nil
+		return formatInt(c, val)
 	case uint:
-		return strconv.FormatUint(uint64(val), 10), nil
+		return formatUint(c, val)
 	case uint8:
-		return strconv.FormatUint(uint64(val), 10), nil
+		return formatUint(c, val)
 	case uint16:
-		return strconv.FormatUint(uint64(val), 10), nil
+		return formatUint(c, val)
 	case uint32:
-		return strconv.FormatUint(uint64(val), 10), nil
+		return formatUint(c, val)
 	case uint64:
-		return strconv.FormatUint(uint64(val), 10), nil
+		return formatUint(c, val)
 	case float32:
-		return strconv.FormatUint(uint64(val), 10), nil
+		return formatFloat(c, float64(val), 32), nil
 	case float64:
-		return strconv.FormatUint(uint64(val), 10), nil
+		return formatFloat(c, val, 64), nil
+	case big.Rat:
+		return formatRat(c, &val), nil
 	case string:
+		if c.isEmpty(val) {
+			return "", c.emptyError("string")
+		}
 		return val, nil
+	case []byte:
+		return toString(c, string(val))
+	case json.Number:
+		return string(val), nil
+	case template.HTML:
+		return string(val), nil
+	case template.HTMLAttr:
+		return string(val), nil
+	case template.CSS:
+		return string(val), nil
+	case template.JS:
+		return string(val), nil
+	case template.JSStr:
+		return string(val), nil
+	case template.URL:
+		return string(val), nil
+	case template.Srcset:
+		return string(val), nil
+	case driver.Valuer:
+		v, err := val.Value()
+		if err != nil {
+			return "", err
+		}
+		return toString(c, v)
 	default:
-		return "", typeError("bool")
+		if name, ok := enumName(val); ok {
+			return name, nil
+		} else if text, ok := netString(val); ok {
+			return text, nil
+		} else if text, ok, err := textOf(val); ok {
+			return text, err
+		}
+		return "", typeError("string")
 	}
 }
 
-// ToSlice casts an interface{} to a []interface{} type.
-func ToSlice(value interface{}) ([]interface{}, error) {
+// toSlice casts an interface{} to a []interface{} type using the converter options.
+func toSlice(c *Converter, value any) ([]any, error) {
 	var res []interface{}
 
 	switch val := value.(type) {
@@ -583,20 +457,38 @@ func ToSlice(value interface{}) ([]interface{}, error) {
 			res = append(res, u)
 		}
 		return res, nil
-	default:
-		return res, typeError("[]interface{}")
+	case string:
+		if items, ok := c.splitSlice(val); ok {
+			for _, item := range items {
+				res = append(res, item)
+			}
+			return res, nil
+		}
 	}
+	return res, typeError("[]interface{}")
 }
 
-// ToBoolSlice casts an interface to a []bool type.
-func ToBoolSlice(i interface{}) ([]bool, error) {
-	if i == nil {
-		return []bool{}, nilErr()
-	}
-
+// toBoolSlice casts an interface to a []bool type using the converter options.
+func toBoolSlice(c *Converter, i any) ([]bool, error) {
 	switch v := i.(type) {
+	case nil:
+		return []bool{}, c.nilError("[]bool")
 	case []bool:
 		return v, nil
+	case []interface{}:
+		return mapSlice(c, v, toBool)
+	case string:
+		if items, ok := c.splitSlice(v); ok {
+			return mapSlice(c, items, (*Converter).parseBool)
+		}
+	case []string:
+		return mapSlice(c, v, (*Converter).parseBool)
+	case []int:
+		return mapSlice(c, v, boolFromNumber[int])
+	case []int64:
+		return mapSlice(c, v, boolFromNumber[int64])
+	case []float64:
+		return mapSlice(c, v, boolFromNumber[float64])
 	}
 
 	kind := reflect.TypeOf(i).Kind()
@@ -605,7 +497,7 @@ func ToBoolSlice(i interface{}) ([]bool, error) {
 		s := reflect.ValueOf(i)
 		a := make([]bool, s.Len())
 		for j := 0; j < s.Len(); j++ {
-			val, err := ToBool(s.Index(j).Interface())
+			val, err := toBool(c, s.Index(j).Interface())
 			if err != nil {
 				return []bool{}, err
 			}
@@ -617,15 +509,35 @@ func ToBoolSlice(i interface{}) ([]bool, error) {
 	}
 }
 
-// ToSignedSlice casts an interface to a signed integer slice type.
-func ToSignedSlice[T int | int8 | int16 | int32 | int64](i interface{}) ([]T, error) {
-	if i == nil {
-		return []T{}, nilErr()
-	}
-
+// toSignedSlice casts an interface to a signed integer slice type using the converter options.
+func toSignedSlice[T signedType](c *Converter, i any) ([]T, error) {
 	switch v := i.(type) {
+	case nil:
+		return []T{}, c.nilError("[]" + typeName[T]())
 	case []T:
 		return v, nil
+	case []interface{}:
+		return mapSlice(c, v, toSigned[T])
+	case string:
+		if items, ok := c.splitSlice(v); ok {
+			return mapSlice(c, items, parseSigned[T])
+		}
+	case []string:
+		return mapSlice(c, v, parseSigned[T])
+	case []int:
+		return mapSlice(c, v, signedFromInt[T, int])
+	case []int32:
+		return mapSlice(c, v, signedFromInt[T, int32])
+	case []int64:
+		return mapSlice(c, v, signedFromInt[T, int64])
+	case []uint:
+		return mapSlice(c, v, signedFromUint[T, uint])
+	case []uint64:
+		return mapSlice(c, v, signedFromUint[T, uint64])
+	case []float32:
+		return mapSlice(c, v, signedFromFloat[T, float32])
+	case []float64:
+		return mapSlice(c, v, signedFromFloat[T, float64])
 	}
 
 	kind := reflect.TypeOf(i).Kind()
@@ -634,7 +546,7 @@ func ToSignedSlice[T int | int8 | int16 | int32 | int64](i interface{}) ([]T, er
 		s := reflect.ValueOf(i)
 		a := make([]T, s.Len())
 		for j := 0; j < s.Len(); j++ {
-			val, err := ToSigned[T](s.Index(j).Interface())
+			val, err := toSigned[T](c, s.Index(j).Interface())
 			if err != nil {
 				return []T{}, err
 			}
@@ -646,15 +558,35 @@ func ToSignedSlice[T int | int8 | int16 | int32 | int64](i interface{}) ([]T, er
 	}
 }
 
-// ToUnsignedSlice casts an interface to a unsigned integer slice type.
-func ToUnsignedSlice[T uint | uint8 | uint16 | uint32 | uint64](i interface{}) ([]T, error) {
-	if i == nil {
-		return []T{}, nilErr()
-	}
-
+// toUnsignedSlice casts an interface to a unsigned integer slice type using the converter options.
+func toUnsignedSlice[T unsignedType](c *Converter, i any) ([]T, error) {
 	switch v := i.(type) {
+	case nil:
+		return []T{}, c.nilError("[]" + typeName[T]())
 	case []T:
 		return v, nil
+	case []interface{}:
+		return mapSlice(c, v, toUnsigned[T])
+	case string:
+		if items, ok := c.splitSlice(v); ok {
+			return mapSlice(c, items, parseUnsigned[T])
+		}
+	case []string:
+		return mapSlice(c, v, parseUnsigned[T])
+	case []int:
+		return mapSlice(c, v, unsignedFromInt[T, int])
+	case []int32:
+		return mapSlice(c, v, unsignedFromInt[T, int32])
+	case []int64:
+		return mapSlice(c, v, unsignedFromInt[T, int64])
+	case []uint:
+		return mapSlice(c, v, unsignedFromUint[T, uint])
+	case []uint64:
+		return mapSlice(c, v, unsignedFromUint[T, uint64])
+	case []float32:
+		return mapSlice(c, v, unsignedFromFloat[T, float32])
+	case []float64:
+		return mapSlice(c, v, unsignedFromFloat[T, float64])
 	}
 
 	kind := reflect.TypeOf(i).Kind()
@@ -663,7 +595,7 @@ func ToUnsignedSlice[T uint | uint8 | uint16 | uint32 | uint64](i interface{}) (
 		s := reflect.ValueOf(i)
 		a := make([]T, s.Len())
 		for j := 0; j < s.Len(); j++ {
-			val, err := ToUnsigned[T](s.Index(j).Interface())
+			val, err := toUnsigned[T](c, s.Index(j).Interface())
 			if err != nil {
 				return []T{}, err
 			}
@@ -675,15 +607,35 @@ func ToUnsignedSlice[T uint | uint8 | uint16 | uint32 | uint64](i interface{}) (
 	}
 }
 
-// ToFloatSlice casts an interface to a float slice type.
-func ToFloatSlice[T float32 | float64](i interface{}) ([]T, error) {
-	if i == nil {
-		return []T{}, nilErr()
-	}
-
+// toFloatSlice casts an interface to a float slice type using the converter options.
+func toFloatSlice[T floatType](c *Converter, i any) ([]T, error) {
 	switch v := i.(type) {
+	case nil:
+		return []T{}, c.nilError("[]" + typeName[T]())
 	case []T:
 		return v, nil
+	case []interface{}:
+		return mapSlice(c, v, toFloat[T])
+	case string:
+		if items, ok := c.splitSlice(v); ok {
+			return mapSlice(c, items, parseFloat[T])
+		}
+	case []string:
+		return mapSlice(c, v, parseFloat[T])
+	case []int:
+		return mapSlice(c, v, floatFromInt[T, int])
+	case []int32:
+		return mapSlice(c, v, floatFromInt[T, int32])
+	case []int64:
+		return mapSlice(c, v, floatFromInt[T, int64])
+	case []uint:
+		return mapSlice(c, v, floatFromUint[T, uint])
+	case []uint64:
+		return mapSlice(c, v, floatFromUint[T, uint64])
+	case []float32:
+		return mapSlice(c, v, floatFromFloat[T, float32])
+	case []float64:
+		return mapSlice(c, v, floatFromFloat[T, float64])
 	}
 
 	kind := reflect.TypeOf(i).Kind()
@@ -692,7 +644,7 @@ func ToFloatSlice[T float32 | float64](i interface{}) ([]T, error) {
 		s := reflect.ValueOf(i)
 		a := make([]T, s.Len())
 		for j := 0; j < s.Len(); j++ {
-			val, err := ToFloat[T](s.Index(j).Interface())
+			val, err := toFloat[T](c, s.Index(j).Interface())
 			if err != nil {
 				return []T{}, err
 			}
@@ -704,15 +656,29 @@ func ToFloatSlice[T float32 | float64](i interface{}) ([]T, error) {
 	}
 }
 
-// ToStringSlice casts an interface to a []string type.
-func ToStringSlice(i interface{}) ([]string, error) {
-	if i == nil {
-		return []string{}, nilErr()
-	}
-
+// toStringSlice casts an interface to a []string type using the converter options.
+func toStringSlice(c *Converter, i any) ([]string, error) {
 	switch v := i.(type) {
+	case nil:
+		return []string{}, c.nilError("[]string")
+	case string:
+		if items, ok := c.splitSlice(v); ok {
+			return items, nil
+		}
 	case []string:
 		return v, nil
+	case []interface{}:
+		return mapSlice(c, v, toString)
+	case []int:
+		return mapSlice(c, v, formatInt[int])
+	case []int64:
+		return mapSlice(c, v, formatInt[int64])
+	case []uint:
+		return mapSlice(c, v, formatUint[uint])
+	case []uint64:
+		return mapSlice(c, v, formatUint[uint64])
+	case []bool:
+		return mapSlice(c, v, formatBool)
 	}
 
 	kind := reflect.TypeOf(i).Kind()
@@ -721,7 +687,7 @@ func ToStringSlice(i interface{}) ([]string, error) {
 		s := reflect.ValueOf(i)
 		a := make([]string, s.Len())
 		for j := 0; j < s.Len(); j++ {
-			val, err := ToString(s.Index(j).Interface())
+			val, err := toString(c, s.Index(j).Interface())
 			if err != nil {
 				return []string{}, err
 			}
@@ -0,0 +1,267 @@
+package gocast
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// FromValues creates a navigable Caster from url.Values.
+// Single values are exposed as strings and repeated values as string slices.
+// Bracket keys are nested (e.g. filter[status] is accessible as "filter.status")
+// and keys ending with [] (e.g. ids[]) are always exposed as slices.
+func FromValues(values url.Values) Caster {
+	return NewCaster(valuesDocument(values))
+}
+
+// valuesDocument converts url.Values into a nested map.
+func valuesDocument(values url.Values) map[string]any {
+	res := make(map[string]any)
+	for key, items := range values {
+		segments, isList := valuesKey(key)
+		if len(items) == 1 && !isList {
+			insertNested(res, segments, items[0])
+		} else {
+			insertNested(res, segments, append([]string{}, items...))
+		}
+	}
+	return res
+}
+
+// valuesKey splits a bracket key (e.g. "a[b][c]" or "ids[]") into path segments.
+// The boolean result reports whether the key ends with [].
+func valuesKey(key string) ([]string, bool) {
+	isList := strings.HasSuffix(key, "[]")
+	key = strings.TrimSuffix(key, "[]")
+
+	name, rest, _ := strings.Cut(key, "[")
+	segments := []string{name}
+	if rest = strings.TrimSuffix(rest, "]"); rest != "" {
+		segments = append(segments, strings.Split(rest, "][")...)
+	}
+	return segments, isList
+}
+
+// bindSources lists the request binding sources in order of precedence.
+var bindSources = []string{"path", "query", "form", "header"}
+
+// Bind fills the struct pointed by dst from the http request.
+// Fields are bound by `path`, `query`, `form` and `header` tags. When a field has
+// multiple tags, the first available source in this order is used.
+// Slice fields receive all values of the key, other fields the first one.
+// Tag options define OpenAPI array encodings: `form` (comma separated),
+// `spaceDelimited` and `pipeDelimited`. The `deepObject` option binds
+// bracket keys like filter[status]=open into struct or map fields.
+// Untagged struct fields are bound recursively, errors of their fields are
+// reported with the field name prefix, e.g. "Price.min".
+// Validation rules (e.g. `query:"page,min=1"`, see Decode) are checked after conversion.
+// Conversion and validation errors of all fields are returned as FieldErrors.
+func Bind(r *http.Request, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind target must be a non-nil struct pointer")
+	}
+
+	if err := parseRequestForm(r); err != nil {
+		return err
+	}
+
+	binder := &requestBinder{
+		request: r,
+		query:   r.URL.Query(),
+		form:    r.PostForm,
+	}
+	binder.bindStruct(rv.Elem(), "")
+	if len(binder.errors) > 0 {
+		return binder.errors
+	}
+	return nil
+}
+
+// parseRequestForm parses the request form and multipart form if not parsed yet.
+func parseRequestForm(r *http.Request) error {
+	if r.PostForm != nil {
+		return nil
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+		err := r.ParseMultipartForm(32 << 20)
+		if err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			return err
+		}
+		return nil
+	}
+	return r.ParseForm()
+}
+
+type requestBinder struct {
+	request *http.Request
+	query   url.Values
+	form    url.Values
+	docs    map[string]map[string]any
+	errors  FieldErrors
+}
+
+// bindStruct binds the fields of the struct, errors are reported by the paths of the fields
+// under the parent path. Nested structs are named by their field names, embedded structs are flattened.
+func (b *requestBinder) bindStruct(v reflect.Value, parent string) {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		rules, path := bindRules(field)
+		tagged, found := false, false
+		for _, source := range bindSources {
+			tag, ok := field.Tag.Lookup(source)
+			if !ok || tag == "-" {
+				continue
+			}
+			tagged = true
+
+			name, options := parseTag(tag)
+			input, ok := b.lookup(source, name, options, field.Type)
+			if !ok {
+				continue
+			}
+
+			found = true
+			fieldPath := joinPath(parent, name)
+			err := decodeValue(defaultConverter, input, v.Field(i), fieldPath)
+			if err == nil {
+				err = validateValue(rules, v.Field(i), fieldPath)
+			}
+			b.addError(fieldPath, err)
+			break
+		}
+
+		if tagged && !found && hasRule(rules, "required") {
+			path = joinPath(parent, path)
+			b.addError(path, &ValidationError{Path: path, Rule: "required"})
+		}
+
+		// Bind nested structs
+		target := v.Field(i)
+		if !tagged && target.Kind() == reflect.Struct && !reflect.PointerTo(target.Type()).Implements(textUnmarshalerType) {
+			if field.Anonymous {
+				b.bindStruct(target, parent)
+			} else {
+				b.bindStruct(target, joinPath(parent, field.Name))
+			}
+		}
+	}
+}
+
+// bindRules returns the validation rules of all source tags of the field and the name of its first source.
+func bindRules(field reflect.StructField) ([]validationRule, string) {
+	var rules []validationRule
+	var path string
+	for _, source := range bindSources {
+		if tag, ok := field.Tag.Lookup(source); ok && tag != "-" {
+			name, options := parseTag(tag)
+			rules = append(rules, parseRules(options)...)
+			if path == "" {
+				path = name
+			}
+		}
+	}
+	return rules, path
+}
+
+// addError adds the error as a field error of the path.
+func (b *requestBinder) addError(path string, err error) {
+	var fe *FieldError
+	if errors.As(fieldError(path, err), &fe) {
+		b.errors = append(b.errors, fe)
+	}
+}
+
+// lookup returns the input value of the key from the source.
+func (b *requestBinder) lookup(source, name string, options []string, typ reflect.Type) (any, bool) {
+	if hasOption(options, "deepObject") {
+		doc := b.document(source)
+		v, ok := doc[name]
+		return v, ok
+	}
+
+	var values []string
+	switch source {
+	case "path":
+		if v := b.request.PathValue(name); v != "" {
+			values = []string{v}
+		}
+	case "query":
+		values = b.query[name]
+	case "form":
+		values = b.form[name]
+	case "header":
+		values = b.request.Header.Values(name)
+	}
+
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	if separator := styleSeparator(options); separator != "" {
+		items := make([]string, 0, len(values))
+		for _, value := range values {
+			if separator == " " {
+				items = append(items, strings.Fields(value)...)
+			} else {
+				items = append(items, splitList(value, separator)...)
+			}
+		}
+		values = items
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if (typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8) || typ.Kind() == reflect.Array {
+		return values, true
+	}
+	return values[0], true
+}
+
+// document returns the nested document of the query or form source.
+func (b *requestBinder) document(source string) map[string]any {
+	if b.docs == nil {
+		b.docs = make(map[string]map[string]any)
+	}
+
+	if doc, ok := b.docs[source]; ok {
+		return doc
+	}
+
+	var doc map[string]any
+	switch source {
+	case "query":
+		doc = valuesDocument(b.query)
+	case "form":
+		doc = valuesDocument(b.form)
+	default:
+		doc = map[string]any{}
+	}
+	b.docs[source] = doc
+	return doc
+}
+
+// styleSeparator returns the separator of OpenAPI array encoding tag options.
+func styleSeparator(options []string) string {
+	switch {
+	case hasOption(options, "form"):
+		return ","
+	case hasOption(options, "spaceDelimited"):
+		return " "
+	case hasOption(options, "pipeDelimited"):
+		return "|"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,112 @@
+package gocast_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestFromValues(t *testing.T) {
+	values, _ := url.ParseQuery("page=2&tag=a&tag=b&filter[status]=open&ids[]=5")
+	doc := gocast.FromValues(values)
+
+	if v := doc.Get("page").IntSafe(0); v != 2 {
+		t.Errorf("Get(page) = %v, expected 2", v)
+	}
+
+	if v := doc.Get("tag").StringSliceSafe(nil); !reflect.DeepEqual(v, []string{"a", "b"}) {
+		t.Errorf("Get(tag) = %v", v)
+	}
+
+	if v := doc.Get("filter.status").StringSafe(""); v != "open" {
+		t.Errorf("Get(filter.status) = %v, expected open", v)
+	}
+
+	if v := doc.Get("ids").IntSliceSafe(nil); !reflect.DeepEqual(v, []int{5}) {
+		t.Errorf("Get(ids) = %v", v)
+	}
+}
+
+func TestBind(t *testing.T) {
+	type Filter struct {
+		Status string `cast:"status"`
+		Limit  int    `cast:"limit"`
+	}
+
+	type Request struct {
+		ID     int64    `path:"id"`
+		Page   int      `query:"page"`
+		Tags   []string `query:"tags"`
+		IDs    []int    `query:"ids,pipeDelimited"`
+		Words  []string `query:"words,spaceDelimited"`
+		Filter Filter   `query:"filter,deepObject"`
+		Name   string   `form:"name" query:"name"`
+		Token  string   `header:"X-Token"`
+	}
+
+	var req Request
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /items/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if err := gocast.Bind(r, &req); err != nil {
+			t.Error(err)
+		}
+	})
+
+	query := "page=3&tags=x&tags=y&ids=1|2|3&words=hello%20%20world&filter[status]=open&filter[limit]=10&name=query"
+	r := httptest.NewRequest("POST", "/items/42?"+query, strings.NewReader("name=form"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("X-Token", "secret")
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+
+	expected := Request{
+		ID:     42,
+		Page:   3,
+		Tags:   []string{"x", "y"},
+		IDs:    []int{1, 2, 3},
+		Words:  []string{"hello", "world"},
+		Filter: Filter{Status: "open", Limit: 10},
+		Name:   "query",
+		Token:  "secret",
+	}
+	if !reflect.DeepEqual(req, expected) {
+		t.Errorf("Bind() = %+v, expected %+v", req, expected)
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	type Request struct {
+		Page  int  `query:"page"`
+		Debug bool `query:"debug"`
+	}
+
+	var req Request
+	r := httptest.NewRequest("GET", "/?page=abc&debug=maybe", nil)
+	err := gocast.Bind(r, &req)
+
+	var errs gocast.FieldErrors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("Bind() error = %v, expected 2 field errors", err)
+	}
+
+	if errs[0].Path != "page" || !gocast.IsCastError(errs[0]) {
+		t.Errorf("Bind() error[0] = %v", errs[0])
+	}
+
+	type Range struct {
+		Min int `query:"min"`
+	}
+	var nested struct {
+		Price Range
+		Size  Range
+	}
+	err = gocast.Bind(httptest.NewRequest("GET", "/?min=x", nil), &nested)
+	if !errors.As(err, &errs) || len(errs) != 2 || errs[0].Path != "Price.min" || errs[1].Path != "Size.min" {
+		t.Errorf("Bind() nested error = %v, expected Price.min and Size.min", err)
+	}
+}
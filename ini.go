@@ -0,0 +1,132 @@
+package gocast
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseINI parses the INI document and returns a navigable Caster.
+// Sections are nested keys, e.g. "port" in [server.http] is accessible as "server.http.port".
+// Keys before the first section are at the root. Lines starting with ";" or "#" are comments.
+// Values may be quoted, keys repeated or suffixed by "[]" (e.g. "hosts[] = a") produce slices.
+// Options configure the document size limit (WithMaxSize) and the conversions of the returned Caster.
+func ParseINI(r io.Reader, opts ...Option) (Caster, error) {
+	return NewConverter(opts...).ParseINI(r)
+}
+
+// ParseINI parses the INI document and returns a navigable Caster using the converter options.
+func (c *Converter) ParseINI(r io.Reader) (Caster, error) {
+	data, err := c.readAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := parseINI(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return c.NewCaster(res), nil
+}
+
+// parseINI parses the INI lines into nested maps.
+func parseINI(data string) (map[string]any, error) {
+	res := make(map[string]any)
+	section := res
+	for i, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		number := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == ';' || line[0] == '#' {
+			continue
+		}
+
+		// Sections
+		if line[0] == '[' {
+			end := strings.IndexByte(line, ']')
+			name := ""
+			if end > 0 {
+				name = strings.TrimSpace(line[1:end])
+			}
+
+			if name == "" || strings.TrimSpace(line[end+1:]) != "" {
+				return nil, iniError(number, "invalid section")
+			}
+
+			var ok bool
+			if section, ok = iniSection(res, name); !ok {
+				return nil, iniError(number, fmt.Sprintf("section %q conflicts with a key", name))
+			}
+			continue
+		}
+
+		end := strings.IndexAny(line, "=:")
+		if end <= 0 {
+			return nil, iniError(number, "expected key = value")
+		}
+
+		key := strings.TrimSpace(line[:end])
+		key, list := strings.CutSuffix(key, "[]")
+		if key == "" {
+			return nil, iniError(number, "expected key = value")
+		}
+
+		value := iniValue(strings.TrimSpace(line[end+1:]))
+
+		switch current := section[key].(type) {
+		case nil:
+			if list {
+				section[key] = []any{value}
+			} else {
+				section[key] = value
+			}
+		case []any:
+			section[key] = append(current, value)
+		case string:
+			section[key] = []any{current, value}
+		default:
+			return nil, iniError(number, fmt.Sprintf("key %q conflicts with a section", key))
+		}
+	}
+	return res, nil
+}
+
+// iniSection returns the nested map of the dotted section name, creating it as needed.
+func iniSection(root map[string]any, name string) (map[string]any, bool) {
+	current := root
+	for _, segment := range strings.Split(name, ".") {
+		segment = strings.TrimSpace(segment)
+		next, exists := current[segment]
+		if !exists {
+			next = make(map[string]any)
+			current[segment] = next
+		}
+
+		nested, ok := next.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = nested
+	}
+	return current, true
+}
+
+// iniValue removes the quotes or the inline comment of the value.
+func iniValue(s string) string {
+	if len(s) > 1 && (s[0] == '"' || s[0] == '\'') {
+		if end := strings.IndexByte(s[1:], s[0]); end >= 0 {
+			return s[1 : end+1]
+		}
+	}
+
+	for i := 1; i < len(s); i++ {
+		if (s[i] == ';' || s[i] == '#') && (s[i-1] == ' ' || s[i-1] == '\t') {
+			return strings.TrimSpace(s[:i])
+		}
+	}
+	return s
+}
+
+// iniError returns an INI syntax error of the line.
+func iniError(line int, msg string) error {
+	return fmt.Errorf("invalid ini: line %d: %s", line, msg)
+}
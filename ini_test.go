@@ -0,0 +1,73 @@
+package gocast_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestParseINI(t *testing.T) {
+	input := `
+; global settings
+name = app
+debug: true
+
+[server]
+port = 8080 ; inline comment
+hosts = a
+hosts = b
+motto = "keep ; this"
+
+[server.tls]
+ports[] = 443
+
+[database]
+# comment
+url = 'postgres://localhost'
+`
+
+	doc, err := gocast.ParseINI(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := doc.Get("name").StringSafe(""); v != "app" {
+		t.Errorf("name = %v, expected app", v)
+	}
+	if !doc.Get("debug").BoolSafe(false) {
+		t.Errorf("debug expected true")
+	}
+	if v := doc.Get("server.port").IntSafe(0); v != 8080 {
+		t.Errorf("server.port = %v, expected 8080", v)
+	}
+	if v := doc.Get("server.hosts").StringSliceSafe(nil); !reflect.DeepEqual(v, []string{"a", "b"}) {
+		t.Errorf("server.hosts = %v", v)
+	}
+	if v := doc.Get("server.motto").StringSafe(""); v != "keep ; this" {
+		t.Errorf("server.motto = %v", v)
+	}
+	if v := doc.Get("server.tls.ports").IntSliceSafe(nil); !reflect.DeepEqual(v, []int{443}) {
+		t.Errorf("server.tls.ports = %v", v)
+	}
+	if v := doc.Get("database.url").StringSafe(""); v != "postgres://localhost" {
+		t.Errorf("database.url = %v", v)
+	}
+}
+
+func TestParseINIErrors(t *testing.T) {
+	tests := []string{
+		"[]",
+		"[section",
+		"novalue",
+		"a = 1\n[a]",
+		"[a.b]\n[a]\nb = 1",
+	}
+
+	for _, input := range tests {
+		if _, err := gocast.ParseINI(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseINI(%q) expected error", input)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package gocast
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// defaultMaxDepth is the nesting limit of parsed documents when no limit is configured.
+const defaultMaxDepth = 1000
+
+// FromJSON parses the JSON document and returns a navigable Caster.
+// Numbers are kept as json.Number to preserve their precision.
+// Options configure the document limits (WithMaxSize, WithMaxDepth)
+// and the conversions of the returned Caster.
+func FromJSON(data []byte, opts ...Option) (Caster, error) {
+	return NewConverter(opts...).FromJSON(data)
+}
+
+// FromJSONReader reads and parses the JSON document from the reader.
+// See FromJSON for details.
+func FromJSONReader(r io.Reader, opts ...Option) (Caster, error) {
+	return NewConverter(opts...).FromJSONReader(r)
+}
+
+// FromJSON parses the JSON document and returns a navigable Caster using the converter options.
+func (c *Converter) FromJSON(data []byte) (Caster, error) {
+	if c.options.maxSize > 0 && int64(len(data)) > c.options.maxSize {
+		return nil, limitError(fmt.Sprintf("%d bytes", c.options.maxSize))
+	}
+
+	maxDepth := c.options.maxDepth
+	if maxDepth <= 0 {
+		maxDepth = defaultMaxDepth
+	}
+	if jsonDepth(data) > maxDepth {
+		return nil, limitError(fmt.Sprintf("%d nesting levels", maxDepth))
+	}
+
+	var doc any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	} else if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid json: unexpected data after document")
+	}
+	return c.NewCaster(doc), nil
+}
+
+// FromJSONReader reads and parses the JSON document from the reader using the converter options.
+func (c *Converter) FromJSONReader(r io.Reader) (Caster, error) {
+	data, err := c.readAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.FromJSON(data)
+}
+
+// readAll reads the document from the reader, failing for documents exceeding the size limit.
+func (c *Converter) readAll(r io.Reader) ([]byte, error) {
+	if c.options.maxSize > 0 {
+		r = io.LimitReader(r, c.options.maxSize+1)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	} else if c.options.maxSize > 0 && int64(len(data)) > c.options.maxSize {
+		return nil, limitError(fmt.Sprintf("%d bytes", c.options.maxSize))
+	}
+	return data, nil
+}
+
+// jsonDepth returns the maximum nesting depth of objects and arrays in the JSON document.
+func jsonDepth(data []byte) int {
+	depth, max := 0, 0
+	inString, escaped := false, false
+	for _, b := range data {
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			if b == '\\' {
+				escaped = true
+			} else if b == '"' {
+				inString = false
+			}
+		case b == '"':
+			inString = true
+		case b == '{' || b == '[':
+			if depth++; depth > max {
+				max = depth
+			}
+		case b == '}' || b == ']':
+			depth--
+		}
+	}
+	return max
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// splitPointer splits a JSON Pointer (RFC 6901) into its unescaped reference tokens.
+// It returns false if the pointer is invalid.
+func splitPointer(pointer string) ([]string, bool) {
+	if pointer == "" {
+		return []string{}, true
+	} else if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+	return tokens, true
+}
@@ -0,0 +1,107 @@
+package gocast_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+const testDocument = `{
+	"name": "shop",
+	"items": [
+		{"id": 9007199254740993, "price": 10.5, "tags": ["a", "b"]},
+		{"id": 2, "price": "12", "active": true}
+	],
+	"a/b": {"m~n": "escaped"}
+}`
+
+func TestFromJSON(t *testing.T) {
+	doc, err := gocast.FromJSON([]byte(testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := doc.Pointer("/items/0/id").Int64(); err != nil || v != 9007199254740993 {
+		t.Errorf("Pointer(/items/0/id) = %v, %v, expected precise int64", v, err)
+	}
+
+	if v := doc.Get("items.1.price").Float64Safe(0); v != 12 {
+		t.Errorf("Get(items.1.price) = %v, expected 12", v)
+	}
+
+	if v, err := doc.Get("items.0.price").Bool(); err != nil || !v {
+		t.Errorf("Get(items.0.price).Bool() = %v, %v, expected true", v, err)
+	}
+
+	if v := doc.Pointer("/items/0/tags").StringSliceSafe(nil); len(v) != 2 || v[1] != "b" {
+		t.Errorf("Pointer(/items/0/tags) = %v", v)
+	}
+
+	if v := doc.Pointer("/a~1b/m~0n").StringSafe(""); v != "escaped" {
+		t.Errorf("Pointer(/a~1b/m~0n) = %v, expected escaped", v)
+	}
+
+	if v := doc.Pointer("").Get("name").StringSafe(""); v != "shop" {
+		t.Errorf("Pointer(\"\").Get(name) = %v, expected shop", v)
+	}
+
+	for _, pointer := range []string{"/items/5", "items", "/missing/key"} {
+		if !doc.Pointer(pointer).IsNil() {
+			t.Errorf("Pointer(%s) expected nil caster", pointer)
+		}
+	}
+}
+
+func TestFromJSONReader(t *testing.T) {
+	doc, err := gocast.FromJSONReader(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := doc.Get("items[1].active").Bool(); err != nil || !v {
+		t.Errorf("Get(items[1].active) = %v, %v", v, err)
+	}
+
+	if _, err := gocast.FromJSONReader(strings.NewReader(`{"a": 1} {}`)); err == nil {
+		t.Errorf("FromJSONReader() with trailing data expected error")
+	}
+
+	if _, err := gocast.FromJSON([]byte(`{"a": `)); err == nil {
+		t.Errorf("FromJSON() with invalid document expected error")
+	}
+}
+
+func TestFromJSONLimits(t *testing.T) {
+	_, err := gocast.FromJSONReader(strings.NewReader(testDocument), gocast.WithMaxSize(16))
+	if !gocast.IsLimitError(err) {
+		t.Errorf("FromJSONReader() with size limit error = %v, expected limit error", err)
+	}
+
+	_, err = gocast.FromJSON([]byte(`[[[["deep"]]]]`), gocast.WithMaxDepth(3))
+	if !gocast.IsLimitError(err) {
+		t.Errorf("FromJSON() with depth limit error = %v, expected limit error", err)
+	}
+
+	if _, err = gocast.FromJSON([]byte(`["[[[[not nested"]`), gocast.WithMaxDepth(1)); err != nil {
+		t.Errorf("FromJSON() with brackets in strings error = %v", err)
+	}
+
+	if _, err = gocast.FromJSON([]byte(strings.Repeat("[", 2000) + strings.Repeat("]", 2000))); !gocast.IsLimitError(err) {
+		t.Errorf("FromJSON() with default depth limit error = %v, expected limit error", err)
+	}
+}
+
+func TestCasterUnmarshal(t *testing.T) {
+	var out struct {
+		Name string `json:"name"`
+	}
+
+	if err := gocast.NewCaster(`{"name":"gocast"}`).Unmarshal(&out); err != nil || out.Name != "gocast" {
+		t.Errorf("Unmarshal(json string) = %+v, %v", out, err)
+	}
+
+	if err := gocast.NewCaster(map[string]any{"name": "map"}).Unmarshal(&out); err != nil || out.Name != "map" {
+		t.Errorf("Unmarshal(map) = %+v, %v", out, err)
+	}
+}
@@ -0,0 +1,345 @@
+package gocast
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var urlType = reflect.TypeFor[url.URL]()
+
+// ToAddr casts an interface to a netip.Addr type.
+// Strings are parsed using netip.ParseAddr (e.g. "10.0.0.1" or "::1").
+func ToAddr(value any) (netip.Addr, error) {
+	return toAddr(defaultConverter, value)
+}
+
+// ToPrefix casts an interface to a netip.Prefix type.
+// Strings are parsed as CIDR (e.g. "10.0.0.0/8"), single addresses are full length prefixes.
+func ToPrefix(value any) (netip.Prefix, error) {
+	return toPrefix(defaultConverter, value)
+}
+
+// ToAddrPort casts an interface to a netip.AddrPort type.
+// Strings are parsed using netip.ParseAddrPort (e.g. "10.0.0.1:80" or "[::1]:80").
+func ToAddrPort(value any) (netip.AddrPort, error) {
+	return toAddrPort(defaultConverter, value)
+}
+
+// ToURL casts an interface to a *url.URL type. Strings are parsed using url.Parse.
+func ToURL(value any) (*url.URL, error) {
+	return toURL(defaultConverter, value)
+}
+
+// ToHostPort casts an interface to a "host:port" string, e.g. "example.com" to "example.com:8080"
+// for the default port 8080. Ports must be numeric, IPv6 hosts are bracketed (e.g. "[::1]:8080").
+func ToHostPort(value any, defaultPort int) (string, error) {
+	return toHostPort(defaultConverter, value, defaultPort)
+}
+
+// ToAddrSlice casts an interface to a []netip.Addr type.
+func ToAddrSlice(value any) ([]netip.Addr, error) {
+	return toNetSlice(defaultConverter, value, "[]netip.Addr", toAddr)
+}
+
+// ToPrefixSlice casts an interface to a []netip.Prefix type.
+func ToPrefixSlice(value any) ([]netip.Prefix, error) {
+	return toNetSlice(defaultConverter, value, "[]netip.Prefix", toPrefix)
+}
+
+// ToAddrPortSlice casts an interface to a []netip.AddrPort type.
+func ToAddrPortSlice(value any) ([]netip.AddrPort, error) {
+	return toNetSlice(defaultConverter, value, "[]netip.AddrPort", toAddrPort)
+}
+
+// ToURLSlice casts an interface to a []*url.URL type.
+func ToURLSlice(value any) ([]*url.URL, error) {
+	return toNetSlice(defaultConverter, value, "[]*url.URL", toURL)
+}
+
+// ToHostPortSlice casts an interface to a slice of "host:port" strings using the default port.
+func ToHostPortSlice(value any, defaultPort int) ([]string, error) {
+	return toNetSlice(defaultConverter, value, "[]string", hostPortOf(defaultPort))
+}
+
+// ToAddr casts an interface to a netip.Addr type using the converter options.
+func (c *Converter) ToAddr(value any) (netip.Addr, error) {
+	return toAddr(c, value)
+}
+
+// ToPrefix casts an interface to a netip.Prefix type using the converter options.
+func (c *Converter) ToPrefix(value any) (netip.Prefix, error) {
+	return toPrefix(c, value)
+}
+
+// ToAddrPort casts an interface to a netip.AddrPort type using the converter options.
+func (c *Converter) ToAddrPort(value any) (netip.AddrPort, error) {
+	return toAddrPort(c, value)
+}
+
+// ToURL casts an interface to a *url.URL type using the converter options.
+func (c *Converter) ToURL(value any) (*url.URL, error) {
+	return toURL(c, value)
+}
+
+// ToHostPort casts an interface to a "host:port" string using the converter options.
+func (c *Converter) ToHostPort(value any, defaultPort int) (string, error) {
+	return toHostPort(c, value, defaultPort)
+}
+
+// ToAddrSlice casts an interface to a []netip.Addr type using the converter options.
+func (c *Converter) ToAddrSlice(value any) ([]netip.Addr, error) {
+	return toNetSlice(c, value, "[]netip.Addr", toAddr)
+}
+
+// ToPrefixSlice casts an interface to a []netip.Prefix type using the converter options.
+func (c *Converter) ToPrefixSlice(value any) ([]netip.Prefix, error) {
+	return toNetSlice(c, value, "[]netip.Prefix", toPrefix)
+}
+
+// ToAddrPortSlice casts an interface to a []netip.AddrPort type using the converter options.
+func (c *Converter) ToAddrPortSlice(value any) ([]netip.AddrPort, error) {
+	return toNetSlice(c, value, "[]netip.AddrPort", toAddrPort)
+}
+
+// ToURLSlice casts an interface to a []*url.URL type using the converter options.
+func (c *Converter) ToURLSlice(value any) ([]*url.URL, error) {
+	return toNetSlice(c, value, "[]*url.URL", toURL)
+}
+
+// ToHostPortSlice casts an interface to a slice of "host:port" strings using the converter options.
+func (c *Converter) ToHostPortSlice(value any, defaultPort int) ([]string, error) {
+	return toNetSlice(c, value, "[]string", hostPortOf(defaultPort))
+}
+
+// toAddr casts an interface to a netip.Addr type using the converter options.
+func toAddr(c *Converter, value any) (netip.Addr, error) {
+	switch val := valueOf(value).(type) {
+	case nil:
+		return netip.Addr{}, c.nilError("netip.Addr")
+	case netip.Addr:
+		return val, nil
+	case net.IP:
+		if addr, ok := netip.AddrFromSlice(val); ok {
+			return addr.Unmap(), nil
+		}
+		return netip.Addr{}, typeError("netip.Addr")
+	case net.IPAddr:
+		return toAddr(c, val.IP)
+	}
+
+	s, err := netText(c, value, "netip.Addr")
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, typeError("netip.Addr")
+	}
+	return addr, nil
+}
+
+// toPrefix casts an interface to a netip.Prefix type using the converter options.
+func toPrefix(c *Converter, value any) (netip.Prefix, error) {
+	switch val := valueOf(value).(type) {
+	case nil:
+		return netip.Prefix{}, c.nilError("netip.Prefix")
+	case netip.Prefix:
+		return val, nil
+	case netip.Addr:
+		return netip.PrefixFrom(val, val.BitLen()), nil
+	case net.IPNet:
+		addr, err := toAddr(c, val.IP)
+		if err != nil {
+			return netip.Prefix{}, typeError("netip.Prefix")
+		}
+		ones, _ := val.Mask.Size()
+		return netip.PrefixFrom(addr, ones), nil
+	}
+
+	s, err := netText(c, value, "netip.Prefix")
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if !strings.Contains(s, "/") {
+		if addr, err := netip.ParseAddr(s); err == nil {
+			return netip.PrefixFrom(addr, addr.BitLen()), nil
+		}
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, typeError("netip.Prefix")
+	}
+	return prefix, nil
+}
+
+// toAddrPort casts an interface to a netip.AddrPort type using the converter options.
+func toAddrPort(c *Converter, value any) (netip.AddrPort, error) {
+	switch val := valueOf(value).(type) {
+	case nil:
+		return netip.AddrPort{}, c.nilError("netip.AddrPort")
+	case netip.AddrPort:
+		return val, nil
+	case net.TCPAddr:
+		return val.AddrPort(), nil
+	case net.UDPAddr:
+		return val.AddrPort(), nil
+	}
+
+	s, err := netText(c, value, "netip.AddrPort")
+	if err != nil {
+		return netip.AddrPort{}, err
+	}
+
+	addrPort, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return netip.AddrPort{}, typeError("netip.AddrPort")
+	}
+	return addrPort, nil
+}
+
+// toURL casts an interface to a *url.URL type using the converter options.
+func toURL(c *Converter, value any) (*url.URL, error) {
+	switch val := valueOf(value).(type) {
+	case nil:
+		return nil, c.nilError("*url.URL")
+	case url.URL:
+		return &val, nil
+	}
+
+	s, err := netText(c, value, "*url.URL")
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, typeError("*url.URL")
+	}
+	return u, nil
+}
+
+// toHostPort casts an interface to a "host:port" string using the converter options.
+func toHostPort(c *Converter, value any, defaultPort int) (string, error) {
+	switch val := valueOf(value).(type) {
+	case nil:
+		return "", c.nilError("host:port")
+	case netip.AddrPort:
+		return val.String(), nil
+	case net.TCPAddr:
+		return val.String(), nil
+	case net.UDPAddr:
+		return val.String(), nil
+	}
+
+	s, err := netText(c, value, "host:port")
+	if err != nil {
+		return "", err
+	}
+
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		// Missing port, bare IPv6 addresses are accepted with or without brackets
+		host = s
+		if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+			host = s[1 : len(s)-1]
+		}
+
+		if addr, err := netip.ParseAddr(host); err == nil {
+			host = addr.String()
+		} else if strings.ContainsAny(host, ":[]") {
+			return "", typeError("host:port")
+		}
+		port = strconv.Itoa(defaultPort)
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		return "", typeError("host:port")
+	} else if strings.ContainsAny(host, " /?#@") {
+		return "", typeError("host:port")
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// hostPortOf returns the host port converter of the default port.
+func hostPortOf(defaultPort int) func(*Converter, any) (string, error) {
+	return func(c *Converter, value any) (string, error) {
+		return toHostPort(c, value, defaultPort)
+	}
+}
+
+// netText returns the trimmed string of the value for network parsing.
+func netText(c *Converter, value any, t string) (string, error) {
+	s, err := toString(c, value)
+	if err != nil {
+		return "", err
+	} else if c.isEmpty(s) {
+		return "", c.emptyError(t)
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", typeError(t)
+	}
+	return s, nil
+}
+
+// netString formats the network value types without value receiver String method.
+func netString(value any) (string, bool) {
+	switch val := value.(type) {
+	case url.URL:
+		return val.String(), true
+	case net.IPNet:
+		return val.String(), true
+	case net.IPAddr:
+		return val.String(), true
+	case net.TCPAddr:
+		return val.String(), true
+	case net.UDPAddr:
+		return val.String(), true
+	default:
+		return "", false
+	}
+}
+
+// toNetSlice casts an interface to a slice of network values using the item converter.
+// Strings are split by the slice delimiter.
+func toNetSlice[T any](c *Converter, i any, t string, fn func(*Converter, any) (T, error)) ([]T, error) {
+	switch v := i.(type) {
+	case nil:
+		return []T{}, c.nilError(t)
+	case []string:
+		// Before []T, host:port strings (T is string) are converted too
+		return mapSlice(c, v, func(c *Converter, s string) (T, error) { return fn(c, s) })
+	case []T:
+		return v, nil
+	case []interface{}:
+		return mapSlice(c, v, fn)
+	case string:
+		if items, ok := c.splitSlice(v); ok {
+			return mapSlice(c, items, func(c *Converter, s string) (T, error) { return fn(c, s) })
+		}
+	}
+
+	switch reflect.TypeOf(i).Kind() {
+	case reflect.Slice, reflect.Array:
+		s := reflect.ValueOf(i)
+		a := make([]T, s.Len())
+		for j := 0; j < s.Len(); j++ {
+			val, err := fn(c, s.Index(j).Interface())
+			if err != nil {
+				return []T{}, err
+			}
+			a[j] = val
+		}
+		return a, nil
+	default:
+		return []T{}, typeError(t)
+	}
+}
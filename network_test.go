@@ -0,0 +1,176 @@
+package gocast_test
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestNetworkConversions(t *testing.T) {
+	if v, err := gocast.ToAddr(" 10.0.0.1 "); err != nil || v != netip.MustParseAddr("10.0.0.1") {
+		t.Errorf("ToAddr(10.0.0.1) = %v, %v", v, err)
+	}
+	if v, err := gocast.ToAddr(net.ParseIP("::1")); err != nil || v != netip.IPv6Loopback() {
+		t.Errorf("ToAddr(net.IP) = %v, %v", v, err)
+	}
+	if v, err := gocast.ToAddr(net.ParseIP("192.168.1.1")); err != nil || !v.Is4() {
+		t.Errorf("ToAddr(net.IP v4) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToPrefix("10.0.0.0/8"); err != nil || v != netip.MustParsePrefix("10.0.0.0/8") {
+		t.Errorf("ToPrefix(10.0.0.0/8) = %v, %v", v, err)
+	}
+	if v, err := gocast.ToPrefix("10.0.0.1"); err != nil || v.String() != "10.0.0.1/32" {
+		t.Errorf("ToPrefix(10.0.0.1) = %v, %v", v, err)
+	}
+	_, ipNet, _ := net.ParseCIDR("192.168.0.0/16")
+	if v, err := gocast.ToPrefix(ipNet); err != nil || v.String() != "192.168.0.0/16" {
+		t.Errorf("ToPrefix(*net.IPNet) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToAddrPort("[::1]:80"); err != nil || v.Port() != 80 || !v.Addr().Is6() {
+		t.Errorf("ToAddrPort([::1]:80) = %v, %v", v, err)
+	}
+	if v, err := gocast.ToAddrPort(&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 443}); err != nil || v.Port() != 443 {
+		t.Errorf("ToAddrPort(*net.TCPAddr) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToURL("https://example.com/path?q=1"); err != nil || v.Host != "example.com" || v.Query().Get("q") != "1" {
+		t.Errorf("ToURL() = %v, %v", v, err)
+	}
+
+	for _, input := range []any{"abc", "10.0.0.256", "", 42} {
+		if _, err := gocast.ToAddr(input); !gocast.IsCastError(err) {
+			t.Errorf("ToAddr(%v) error = %v, expected cast error", input, err)
+		}
+	}
+	if _, err := gocast.ToPrefix("10.0.0.0/33"); !gocast.IsCastError(err) {
+		t.Errorf("ToPrefix(10.0.0.0/33) error = %v, expected cast error", err)
+	}
+	if _, err := gocast.ToAddrPort("10.0.0.1"); !gocast.IsCastError(err) {
+		t.Errorf("ToAddrPort(10.0.0.1) error = %v, expected cast error", err)
+	}
+	if _, err := gocast.ToURL("http://[::1"); !gocast.IsCastError(err) {
+		t.Errorf("ToURL(invalid) error = %v, expected cast error", err)
+	}
+	if _, err := gocast.ToURL(nil); !gocast.IsNilError(err) {
+		t.Errorf("ToURL(nil) error = %v, expected nil error", err)
+	}
+}
+
+func TestToHostPort(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"example.com", "example.com:8080"},
+		{"example.com:80", "example.com:80"},
+		{":9000", ":9000"},
+		{"10.0.0.1", "10.0.0.1:8080"},
+		{"::1", "[::1]:8080"},
+		{"[::1]", "[::1]:8080"},
+		{"[::1]:443", "[::1]:443"},
+		{netip.MustParseAddrPort("10.0.0.1:53"), "10.0.0.1:53"},
+	}
+
+	for _, test := range tests {
+		if v, err := gocast.ToHostPort(test.input, 8080); err != nil || v != test.expected {
+			t.Errorf("ToHostPort(%v) = %q, %v, expected %q", test.input, v, err, test.expected)
+		}
+	}
+
+	for _, input := range []string{"host:http", "host:70000", "a b:80", "[::1", ""} {
+		if _, err := gocast.ToHostPort(input, 8080); !gocast.IsCastError(err) {
+			t.Errorf("ToHostPort(%q) error = %v, expected cast error", input, err)
+		}
+	}
+}
+
+func TestNetworkSlices(t *testing.T) {
+	conv := gocast.NewConverter(gocast.WithSliceDelimiter(","))
+	if v, err := conv.ToAddrSlice("10.0.0.1, ::1"); err != nil || len(v) != 2 || v[1] != netip.IPv6Loopback() {
+		t.Errorf("ToAddrSlice() = %v, %v", v, err)
+	}
+	if v, err := conv.ToPrefixSlice([]string{"10.0.0.0/8", "fd00::/8"}); err != nil || len(v) != 2 {
+		t.Errorf("ToPrefixSlice() = %v, %v", v, err)
+	}
+	if v, err := conv.ToAddrPortSlice([]any{"10.0.0.1:80"}); err != nil || len(v) != 1 || v[0].Port() != 80 {
+		t.Errorf("ToAddrPortSlice() = %v, %v", v, err)
+	}
+	if v, err := conv.ToURLSlice("http://a,https://b"); err != nil || len(v) != 2 || v[1].Host != "b" {
+		t.Errorf("ToURLSlice() = %v, %v", v, err)
+	}
+	if v, err := conv.ToHostPortSlice("a,b:81", 80); err != nil || !reflect.DeepEqual(v, []string{"a:80", "b:81"}) {
+		t.Errorf("ToHostPortSlice() = %v, %v", v, err)
+	}
+	if v, err := gocast.ToHostPortSlice([]string{"example.com", "b:81"}, 8080); err != nil ||
+		!reflect.DeepEqual(v, []string{"example.com:8080", "b:81"}) {
+		t.Errorf("ToHostPortSlice([]string) = %v, %v", v, err)
+	}
+	if _, err := gocast.ToHostPortSlice([]string{"a", "bad host:x"}, 80); !gocast.IsCastError(err) {
+		t.Errorf("ToHostPortSlice([]string) invalid item error = %v, expected cast error", err)
+	}
+	if _, err := conv.ToAddrSlice("10.0.0.1,x"); !gocast.IsCastError(err) {
+		t.Errorf("ToAddrSlice() invalid item error = %v, expected cast error", err)
+	}
+
+	caster := gocast.NewCaster("10.0.0.1")
+	if v, err := caster.Addr(); err != nil || v.String() != "10.0.0.1" {
+		t.Errorf("Caster.Addr() = %v, %v", v, err)
+	}
+	if v, err := caster.HostPort(22); err != nil || v != "10.0.0.1:22" {
+		t.Errorf("Caster.HostPort() = %v, %v", v, err)
+	}
+	if v, err := gocast.NewCaster([]string{"a", "[::1]"}).HostPortSlice(22); err != nil ||
+		!reflect.DeepEqual(v, []string{"a:22", "[::1]:22"}) {
+		t.Errorf("Caster.HostPortSlice() = %v, %v", v, err)
+	}
+	if v, err := gocast.NewCaster("http://a/b").URL(); err != nil || v.Path != "/b" {
+		t.Errorf("Caster.URL() = %v, %v", v, err)
+	}
+}
+
+func TestNetworkString(t *testing.T) {
+	u, _ := url.Parse("https://example.com/x")
+	_, ipNet, _ := net.ParseCIDR("10.0.0.0/8")
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{net.ParseIP("10.0.0.1"), "10.0.0.1"},
+		{netip.MustParseAddr("::1"), "::1"},
+		{netip.MustParsePrefix("10.0.0.0/8"), "10.0.0.0/8"},
+		{u, "https://example.com/x"},
+		{*u, "https://example.com/x"},
+		{ipNet, "10.0.0.0/8"},
+		{&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 80}, "10.0.0.1:80"},
+	}
+
+	for _, test := range tests {
+		if v, err := gocast.ToString(test.input); err != nil || v != test.expected {
+			t.Errorf("ToString(%v) = %q, %v, expected %q", test.input, v, err, test.expected)
+		}
+	}
+
+	type Config struct {
+		Endpoint url.URL      `cast:"endpoint"`
+		Callback *url.URL     `cast:"callback"`
+		Bind     netip.Addr   `cast:"bind"`
+		Allow    netip.Prefix `cast:"allow"`
+	}
+
+	var config Config
+	err := gocast.Decode(map[string]any{
+		"endpoint": "https://api.example.com",
+		"callback": "http://localhost/cb",
+		"bind":     "0.0.0.0",
+		"allow":    "10.0.0.0/8",
+	}, &config)
+	if err != nil || config.Endpoint.Host != "api.example.com" || config.Callback.Path != "/cb" || !config.Bind.IsUnspecified() || config.Allow.Bits() != 8 {
+		t.Errorf("Decode() = %+v, %v", config, err)
+	}
+}
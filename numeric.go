@@ -0,0 +1,487 @@
+package gocast
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type signedType interface {
+	int | int8 | int16 | int32 | int64
+}
+
+type unsignedType interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+
+type floatType interface {
+	float32 | float64
+}
+
+type numberType interface {
+	signedType | unsignedType | floatType
+}
+
+// signedProviderOf converts value using the provider interface of the signed integer type T.
+// The second return value reports whether value implements the provider.
+func signedProviderOf[T signedType](value any) (T, bool, error) {
+	var sample T
+	switch any(sample).(type) {
+	case int:
+		switch val := value.(type) {
+		case IntErrorProvider:
+			v, err := val.Int()
+			return T(v), true, err
+		case IntProvider:
+			return T(val.Int()), true, nil
+		}
+	case int8:
+		switch val := value.(type) {
+		case Int8ErrorProvider:
+			v, err := val.Int8()
+			return T(v), true, err
+		case Int8Provider:
+			return T(val.Int8()), true, nil
+		}
+	case int16:
+		switch val := value.(type) {
+		case Int16ErrorProvider:
+			v, err := val.Int16()
+			return T(v), true, err
+		case Int16Provider:
+			return T(val.Int16()), true, nil
+		}
+	case int32:
+		switch val := value.(type) {
+		case Int32ErrorProvider:
+			v, err := val.Int32()
+			return T(v), true, err
+		case Int32Provider:
+			return T(val.Int32()), true, nil
+		}
+	case int64:
+		switch val := value.(type) {
+		case Int64ErrorProvider:
+			v, err := val.Int64()
+			return T(v), true, err
+		case Int64Provider:
+			return T(val.Int64()), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// unsignedProviderOf converts value using the provider interface of the unsigned integer type T.
+// The second return value reports whether value implements the provider.
+func unsignedProviderOf[T unsignedType](value any) (T, bool, error) {
+	var sample T
+	switch any(sample).(type) {
+	case uint:
+		switch val := value.(type) {
+		case UintErrorProvider:
+			v, err := val.Uint()
+			return T(v), true, err
+		case UintProvider:
+			return T(val.Uint()), true, nil
+		}
+	case uint8:
+		switch val := value.(type) {
+		case Uint8ErrorProvider:
+			v, err := val.Uint8()
+			return T(v), true, err
+		case Uint8Provider:
+			return T(val.Uint8()), true, nil
+		}
+	case uint16:
+		switch val := value.(type) {
+		case Uint16ErrorProvider:
+			v, err := val.Uint16()
+			return T(v), true, err
+		case Uint16Provider:
+			return T(val.Uint16()), true, nil
+		}
+	case uint32:
+		switch val := value.(type) {
+		case Uint32ErrorProvider:
+			v, err := val.Uint32()
+			return T(v), true, err
+		case Uint32Provider:
+			return T(val.Uint32()), true, nil
+		}
+	case uint64:
+		switch val := value.(type) {
+		case Uint64ErrorProvider:
+			v, err := val.Uint64()
+			return T(v), true, err
+		case Uint64Provider:
+			return T(val.Uint64()), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// floatProviderOf converts value using the provider interface of the float type T.
+// The second return value reports whether value implements the provider.
+func floatProviderOf[T floatType](value any) (T, bool, error) {
+	var sample T
+	switch any(sample).(type) {
+	case float32:
+		switch val := value.(type) {
+		case Float32ErrorProvider:
+			v, err := val.Float32()
+			return T(v), true, err
+		case Float32Provider:
+			return T(val.Float32()), true, nil
+		}
+	case float64:
+		switch val := value.(type) {
+		case Float64ErrorProvider:
+			v, err := val.Float64()
+			return T(v), true, err
+		case Float64Provider:
+			return T(val.Float64()), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// signedOverflow returns the result of an out of range value for the signed integer type T.
+// The value is clamped to the nearest bound in OverflowClamp mode.
+func signedOverflow[T signedType](c *Converter, negative bool) (T, error) {
+	if c.options.overflow != OverflowClamp {
+		return 0, overflowError(typeName[T]())
+	}
+
+	min, max := signedBounds[T]()
+	if negative {
+		return T(min), nil
+	}
+	return T(max), nil
+}
+
+// unsignedOverflow returns the result of an out of range value for the unsigned integer type T.
+// The value is clamped to the nearest bound in OverflowClamp mode.
+func unsignedOverflow[T unsignedType](c *Converter, negative bool) (T, error) {
+	if c.options.overflow != OverflowClamp {
+		return 0, overflowError(typeName[T]())
+	} else if negative {
+		return 0, nil
+	}
+	return T(unsignedMax[T]()), nil
+}
+
+// floatOverflow returns the result of an out of range value for the float type T.
+// The value is clamped to the nearest bound in OverflowClamp mode.
+func floatOverflow[T floatType](c *Converter, negative bool) (T, error) {
+	if c.options.overflow != OverflowClamp {
+		return 0, overflowError(typeName[T]())
+	} else if negative {
+		return T(-floatMax[T]()), nil
+	}
+	return T(floatMax[T]()), nil
+}
+
+// signedFromInt converts a signed integer to the signed integer type T with range check.
+func signedFromInt[T signedType, S signedType](c *Converter, v S) (T, error) {
+	if !intInRange[T](int64(v)) {
+		return signedOverflow[T](c, v < 0)
+	}
+	return T(v), nil
+}
+
+// signedFromUint converts an unsigned integer to the signed integer type T with range check.
+func signedFromUint[T signedType, S unsignedType](c *Converter, v S) (T, error) {
+	if uint64(v) > math.MaxInt64 || !intInRange[T](int64(v)) {
+		return signedOverflow[T](c, false)
+	}
+	return T(v), nil
+}
+
+// signedFromFloat rounds a float to the signed integer type T with range check.
+func signedFromFloat[T signedType, S floatType](c *Converter, v S) (T, error) {
+	f, ok := c.round(float64(v))
+	if !ok || math.IsNaN(f) {
+		return 0, typeError(typeName[T]())
+	} else if f < math.MinInt64 || f >= math.MaxInt64 || !intInRange[T](int64(f)) {
+		return signedOverflow[T](c, f < 0)
+	}
+	return T(f), nil
+}
+
+// unsignedFromInt converts a signed integer to the unsigned integer type T with range check.
+func unsignedFromInt[T unsignedType, S signedType](c *Converter, v S) (T, error) {
+	if v < 0 || !uintInRange[T](uint64(v)) {
+		return unsignedOverflow[T](c, v < 0)
+	}
+	return T(v), nil
+}
+
+// unsignedFromUint converts an unsigned integer to the unsigned integer type T with range check.
+func unsignedFromUint[T unsignedType, S unsignedType](c *Converter, v S) (T, error) {
+	if !uintInRange[T](uint64(v)) {
+		return unsignedOverflow[T](c, false)
+	}
+	return T(v), nil
+}
+
+// unsignedFromFloat rounds a float to the unsigned integer type T with range check.
+func unsignedFromFloat[T unsignedType, S floatType](c *Converter, v S) (T, error) {
+	f, ok := c.round(float64(v))
+	if !ok || math.IsNaN(f) {
+		return 0, typeError(typeName[T]())
+	} else if f < 0 || f >= math.MaxUint64 || !uintInRange[T](uint64(f)) {
+		return unsignedOverflow[T](c, f < 0)
+	}
+	return T(f), nil
+}
+
+// signedFromRat converts an exact rational number to the signed integer type T with range check.
+// Fractions are rounded using the rounding mode.
+func signedFromRat[T signedType](c *Converter, r *big.Rat) (T, error) {
+	if !r.IsInt() {
+		f, _ := r.Float64()
+		return signedFromFloat[T](c, f)
+	} else if !r.Num().IsInt64() {
+		return signedOverflow[T](c, r.Sign() < 0)
+	}
+	return signedFromInt[T](c, r.Num().Int64())
+}
+
+// unsignedFromRat converts an exact rational number to the unsigned integer type T with range check.
+// Fractions are rounded using the rounding mode.
+func unsignedFromRat[T unsignedType](c *Converter, r *big.Rat) (T, error) {
+	if !r.IsInt() {
+		f, _ := r.Float64()
+		return unsignedFromFloat[T](c, f)
+	} else if r.Sign() < 0 || !r.Num().IsUint64() {
+		return unsignedOverflow[T](c, r.Sign() < 0)
+	}
+	return unsignedFromUint[T](c, r.Num().Uint64())
+}
+
+// floatFromRat converts a rational number to the float type T with range check.
+func floatFromRat[T floatType](c *Converter, r *big.Rat) (T, error) {
+	f, _ := r.Float64()
+	if math.IsInf(f, 0) {
+		return floatOverflow[T](c, f < 0)
+	}
+	return floatFromFloat[T](c, f)
+}
+
+// parseDecimal parses a decimal number (e.g. "-1.5" or "2e3") as an exact rational number.
+func parseDecimal(s string) (*big.Rat, bool) {
+	if s == "" || strings.ContainsAny(s, "/_") {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// parseQuotient parses the decimal terms of a fraction or ratio as their exact quotient.
+func parseQuotient(numerator, denominator string) (*big.Rat, bool) {
+	n, ok := parseDecimal(strings.TrimSpace(numerator))
+	if !ok {
+		return nil, false
+	}
+
+	d, ok := parseDecimal(strings.TrimSpace(denominator))
+	if !ok || d.Sign() == 0 {
+		return nil, false
+	}
+	return n.Quo(n, d), true
+}
+
+// floatFromInt converts a signed integer to the float type T.
+func floatFromInt[T floatType, S signedType](_ *Converter, v S) (T, error) {
+	return T(v), nil
+}
+
+// floatFromUint converts an unsigned integer to the float type T.
+func floatFromUint[T floatType, S unsignedType](_ *Converter, v S) (T, error) {
+	return T(v), nil
+}
+
+// floatFromFloat converts a float to the float type T with range check.
+func floatFromFloat[T floatType, S floatType](c *Converter, v S) (T, error) {
+	if !floatInRange[T](float64(v)) {
+		return floatOverflow[T](c, v < 0)
+	}
+	return T(v), nil
+}
+
+// boolFromNumber reports whether the number is not zero.
+func boolFromNumber[S numberType](_ *Converter, v S) (bool, error) {
+	return v != 0, nil
+}
+
+// parseSigned parses a numeric string to the signed integer type T.
+// Integer strings accept base prefixes and float strings are rounded.
+func parseSigned[T signedType](c *Converter, s string) (T, error) {
+	if c.isEmpty(s) {
+		return 0, c.emptyError(typeName[T]())
+	}
+
+	s = c.normalizeNumber(s)
+	if r, ok := c.parseMultiplied(s); ok {
+		return signedFromRat[T](c, r)
+	}
+
+	base := c.options.numberFormat.Base
+	if base != 0 || !isFloatText(s) {
+		i, err := strconv.ParseInt(s, base, 64)
+		if err == nil {
+			return signedFromInt[T](c, i)
+		} else if isRangeError(err) {
+			return signedOverflow[T](c, len(s) > 0 && s[0] == '-')
+		} else if base != 0 && base != 10 {
+			return 0, typeError(typeName[T]())
+		}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return signedFromFloat[T](c, f)
+	} else if isRangeError(err) {
+		return signedOverflow[T](c, len(s) > 0 && s[0] == '-')
+	}
+	return 0, typeError(typeName[T]())
+}
+
+// parseUnsigned parses a numeric string to the unsigned integer type T.
+// Integer strings accept base prefixes and float strings are rounded.
+func parseUnsigned[T unsignedType](c *Converter, s string) (T, error) {
+	if c.isEmpty(s) {
+		return 0, c.emptyError(typeName[T]())
+	}
+
+	s = c.normalizeNumber(s)
+	if r, ok := c.parseMultiplied(s); ok {
+		return unsignedFromRat[T](c, r)
+	}
+
+	base := c.options.numberFormat.Base
+	if base != 0 || !isFloatText(s) {
+		u, err := strconv.ParseUint(s, base, 64)
+		if err == nil {
+			return unsignedFromUint[T](c, u)
+		} else if isRangeError(err) {
+			return unsignedOverflow[T](c, false)
+		}
+
+		if i, err := strconv.ParseInt(s, base, 64); err == nil {
+			return unsignedFromInt[T](c, i)
+		} else if isRangeError(err) {
+			return unsignedOverflow[T](c, true)
+		} else if base != 0 && base != 10 {
+			return 0, typeError(typeName[T]())
+		}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return unsignedFromFloat[T](c, f)
+	} else if isRangeError(err) {
+		return unsignedOverflow[T](c, len(s) > 0 && s[0] == '-')
+	}
+	return 0, typeError(typeName[T]())
+}
+
+// parseFloat parses a numeric string to the float type T.
+func parseFloat[T floatType](c *Converter, s string) (T, error) {
+	if c.isEmpty(s) {
+		return 0, c.emptyError(typeName[T]())
+	}
+
+	s = c.normalizeNumber(s)
+	if r, ok := c.parseModed(s); ok {
+		return floatFromRat[T](c, r)
+	} else if r, ok := c.parseMultiplied(s); ok {
+		return floatFromRat[T](c, r)
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return floatFromFloat[T](c, f)
+	} else if isRangeError(err) {
+		return floatOverflow[T](c, len(s) > 0 && s[0] == '-')
+	}
+
+	if i, err := strconv.ParseInt(s, c.options.numberFormat.Base, 64); err == nil {
+		return floatFromInt[T](c, i)
+	}
+	return 0, typeError(typeName[T]())
+}
+
+// parseBool parses a bool string.
+func parseBool(s string) (bool, error) {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, typeError("bool")
+	}
+	return v, nil
+}
+
+// formatInt formats a signed integer as decimal string or byte size.
+func formatInt[S signedType](c *Converter, v S) (string, error) {
+	if c.options.bytesFormat {
+		if v < 0 {
+			return "-" + FormatBytes(uint64(^int64(v))+1, c.options.bytesUnits, c.options.bytesPrecision), nil
+		}
+		return FormatBytes(uint64(v), c.options.bytesUnits, c.options.bytesPrecision), nil
+	}
+	return strconv.FormatInt(int64(v), 10), nil
+}
+
+// formatUint formats an unsigned integer as decimal string or byte size.
+func formatUint[S unsignedType](c *Converter, v S) (string, error) {
+	if c.options.bytesFormat {
+		return FormatBytes(uint64(v), c.options.bytesUnits, c.options.bytesPrecision), nil
+	}
+	return strconv.FormatUint(uint64(v), 10), nil
+}
+
+// formatBool formats a bool as string.
+func formatBool(_ *Converter, v bool) (string, error) {
+	return strconv.FormatBool(v), nil
+}
+
+// isFloatText checks if a numeric string is written in float notation.
+// It is used to skip integer parsing, so float strings are parsed without allocation.
+func isFloatText(s string) bool {
+	hex := len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+	if len(s) > 3 && (s[0] == '-' || s[0] == '+') {
+		hex = s[1] == '0' && (s[2] == 'x' || s[2] == 'X')
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '.', 'p', 'P':
+			return true
+		case 'e', 'E':
+			if !hex {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRangeError checks if the strconv error is a range error.
+func isRangeError(err error) bool {
+	var numErr *strconv.NumError
+	return errors.As(err, &numErr) && numErr.Err == strconv.ErrRange
+}
+
+// mapSlice converts each item of the slice using fn and stops on the first error.
+func mapSlice[S any, T any](c *Converter, items []S, fn func(*Converter, S) (T, error)) ([]T, error) {
+	res := make([]T, len(items))
+	for i, item := range items {
+		v, err := fn(c, item)
+		if err != nil {
+			return []T{}, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
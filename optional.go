@@ -0,0 +1,231 @@
+package gocast
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Optional holds a value that may be absent.
+// It distinguishes an omitted value from the zero value, e.g. in PATCH requests.
+// Optional implements json.Marshaler, json.Unmarshaler, sql.Scanner and driver.Valuer,
+// null values are decoded as unset optionals and unset optionals are encoded as null.
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Some creates a set Optional holding the value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Get returns the value and reports whether the optional is set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// OrElse returns the value or the fallback if the optional is not set.
+func (o Optional[T]) OrElse(fallback T) T {
+	if !o.set {
+		return fallback
+	}
+	return o.value
+}
+
+// IsSet reports whether the optional holds a value.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// Ptr returns a pointer to a copy of the value or nil if the optional is not set.
+func (o Optional[T]) Ptr() *T {
+	if !o.set {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
+// String returns the string representation of the value or an empty string if not set.
+func (o Optional[T]) String() string {
+	if !o.set {
+		return ""
+	}
+	return fmt.Sprint(o.value)
+}
+
+// MarshalJSON encodes the value or null if the optional is not set.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes the value, null unsets the optional.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Optional[T]{}
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface, NULL unsets the optional.
+func (o *Optional[T]) Scan(src any) error {
+	if b, ok := src.([]byte); ok {
+		src = bytes.Clone(b)
+	}
+	return o.setOptional(defaultConverter, src)
+}
+
+// Value implements the driver.Valuer interface, unset optionals are NULL.
+// Unsigned values above math.MaxInt64 are converted to decimal strings.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.set {
+		return nil, nil
+	}
+
+	// The default converter rejects unsigned values with the high bit set
+	if _, ok := any(o.value).(driver.Valuer); !ok {
+		switch rv := reflect.ValueOf(o.value); rv.Kind() {
+		case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+			if rv.Uint() > math.MaxInt64 {
+				return strconv.FormatUint(rv.Uint(), 10), nil
+			}
+		}
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// setOptional converts the value and sets the optional, it is used by Decode.
+func (o *Optional[T]) setOptional(c *Converter, value any) error {
+	res, err := toOptional[T](c, value)
+	if err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// optionalGetter is implemented by Optional values.
+type optionalGetter interface {
+	getOptional() (any, bool)
+}
+
+// getOptional returns the value and reports whether the optional is set, it is used by ToString.
+func (o Optional[T]) getOptional() (any, bool) {
+	return o.value, o.set
+}
+
+// optionalSetter is implemented by Optional pointers.
+type optionalSetter interface {
+	setOptional(c *Converter, value any) error
+}
+
+// ToOptional casts an interface to an Optional[T] type.
+// Nil values (and values reported as missing by the converter policies) result in an unset optional.
+// Primary types, time.Time and time.Duration are converted using the package converters,
+// other types are decoded using Decode.
+func ToOptional[T any](value interface{}) (Optional[T], error) {
+	return toOptional[T](defaultConverter, value)
+}
+
+// ToPtr casts an interface to a *T type.
+// Nil values (and values reported as missing by the converter policies) result in a nil pointer.
+func ToPtr[T any](value interface{}) (*T, error) {
+	return toPtr[T](defaultConverter, value)
+}
+
+// ToOptionalWith casts an interface to an Optional[T] type using the converter options.
+func ToOptionalWith[T any](c *Converter, value any) (Optional[T], error) {
+	return toOptional[T](c, value)
+}
+
+// ToPtrWith casts an interface to a *T type using the converter options.
+func ToPtrWith[T any](c *Converter, value any) (*T, error) {
+	return toPtr[T](c, value)
+}
+
+// toOptional casts an interface to an Optional[T] type using the converter options.
+func toOptional[T any](c *Converter, value any) (Optional[T], error) {
+	if valueOf(value) == nil {
+		return Optional[T]{}, nil
+	}
+
+	v, err := convertTo[T](c, value)
+	if IsNilError(err) {
+		return Optional[T]{}, nil
+	} else if err != nil {
+		return Optional[T]{}, err
+	}
+	return Some(v), nil
+}
+
+// toPtr casts an interface to a *T type using the converter options.
+func toPtr[T any](c *Converter, value any) (*T, error) {
+	res, err := toOptional[T](c, value)
+	return res.Ptr(), err
+}
+
+// convertTo casts an interface to the type T using the converter options.
+// Types without a converter are decoded using Decode.
+func convertTo[T any](c *Converter, value any) (T, error) {
+	var res T
+	var v any
+	var err error
+	switch any(res).(type) {
+	case bool:
+		v, err = toBool(c, value)
+	case int:
+		v, err = toSigned[int](c, value)
+	case int8:
+		v, err = toSigned[int8](c, value)
+	case int16:
+		v, err = toSigned[int16](c, value)
+	case int32:
+		v, err = toSigned[int32](c, value)
+	case int64:
+		v, err = toSigned[int64](c, value)
+	case uint:
+		v, err = toUnsigned[uint](c, value)
+	case uint8:
+		v, err = toUnsigned[uint8](c, value)
+	case uint16:
+		v, err = toUnsigned[uint16](c, value)
+	case uint32:
+		v, err = toUnsigned[uint32](c, value)
+	case uint64:
+		v, err = toUnsigned[uint64](c, value)
+	case float32:
+		v, err = toFloat[float32](c, value)
+	case float64:
+		v, err = toFloat[float64](c, value)
+	case string:
+		v, err = toString(c, value)
+	case time.Time:
+		v, err = toTime(c, value)
+	case time.Duration:
+		v, err = toDuration(c, value)
+	default:
+		err = decode(c, value, &res)
+		return res, err
+	}
+
+	if err != nil {
+		return res, err
+	}
+	return v.(T), nil
+}
@@ -0,0 +1,135 @@
+package gocast_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestToOptional(t *testing.T) {
+	if o, err := gocast.ToOptional[int](nil); err != nil || o.IsSet() {
+		t.Errorf("ToOptional(nil) = %v, %v, expected unset", o, err)
+	}
+
+	o, err := gocast.ToOptional[int]("0")
+	if v, ok := o.Get(); err != nil || !ok || v != 0 {
+		t.Errorf("ToOptional(0) = %v, %v, expected set 0", o, err)
+	}
+
+	if _, err := gocast.ToOptional[int]("abc"); !gocast.IsCastError(err) {
+		t.Errorf("ToOptional(abc) error = %v, expected cast error", err)
+	}
+
+	if v := (gocast.Optional[string]{}).OrElse("fallback"); v != "fallback" {
+		t.Errorf("OrElse() = %v, expected fallback", v)
+	}
+
+	if o, err := gocast.ToOptional[time.Duration]("1s"); err != nil || o.OrElse(0) != time.Second {
+		t.Errorf("ToOptional[time.Duration](1s) = %v, %v", o, err)
+	}
+
+	if p, err := gocast.ToPtr[float64](nil); err != nil || p != nil {
+		t.Errorf("ToPtr(nil) = %v, %v, expected nil pointer", p, err)
+	}
+
+	if p, err := gocast.ToPtr[float64]("1.5"); err != nil || p == nil || *p != 1.5 {
+		t.Errorf("ToPtr(1.5) = %v, %v", p, err)
+	}
+
+	if _, err := gocast.ToString(gocast.Optional[int]{}); !gocast.IsNilError(err) {
+		t.Errorf("ToString(unset Optional) error = %v, expected nil error", err)
+	}
+	if v, err := gocast.ToString(gocast.Some(1.5)); err != nil || v != "1.5" {
+		t.Errorf("ToString(Some(1.5)) = %v, %v", v, err)
+	}
+
+	empty := gocast.NewCaster("").With(gocast.WithEmptyPolicy(gocast.PolicyMissing))
+	if o, err := empty.IntOptional(); err != nil || o.IsSet() {
+		t.Errorf("IntOptional(\"\") with PolicyMissing = %v, %v, expected unset", o, err)
+	}
+}
+
+func TestCasterOptional(t *testing.T) {
+	caster := gocast.NewCaster(map[string]any{"count": 0, "name": "gocast"})
+
+	if o, err := caster.Get("count").IntOptional(); err != nil || !o.IsSet() {
+		t.Errorf("Get(count).IntOptional() = %v, %v, expected set", o, err)
+	}
+
+	if o, err := caster.Get("missing").IntOptional(); err != nil || o.IsSet() {
+		t.Errorf("Get(missing).IntOptional() = %v, %v, expected unset", o, err)
+	}
+
+	if p, err := caster.Get("name").StringPtr(); err != nil || p == nil || *p != "gocast" {
+		t.Errorf("Get(name).StringPtr() = %v, %v", p, err)
+	}
+}
+
+func TestOptionalJSON(t *testing.T) {
+	type Patch struct {
+		Name  gocast.Optional[string] `json:"name"`
+		Count gocast.Optional[int]    `json:"count"`
+		Flag  gocast.Optional[bool]   `json:"flag"`
+	}
+
+	var patch Patch
+	if err := json.Unmarshal([]byte(`{"count":0,"flag":null}`), &patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if patch.Name.IsSet() || !patch.Count.IsSet() || patch.Flag.IsSet() {
+		t.Errorf("Unmarshal() = %+v", patch)
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil || string(data) != `{"name":null,"count":0,"flag":null}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+}
+
+func TestOptionalSQL(t *testing.T) {
+	var o gocast.Optional[int64]
+	if err := o.Scan([]byte("42")); err != nil || o.OrElse(0) != 42 {
+		t.Errorf("Scan(42) = %v, %v", o, err)
+	}
+
+	if err := o.Scan(nil); err != nil || o.IsSet() {
+		t.Errorf("Scan(nil) = %v, %v, expected unset", o, err)
+	}
+
+	if v, err := o.Value(); err != nil || v != nil {
+		t.Errorf("Value() of unset = %v, %v", v, err)
+	}
+
+	if v, err := gocast.Some(int64(7)).Value(); err != nil || v != int64(7) {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	if v, err := gocast.Some(uint64(math.MaxUint64)).Value(); err != nil || v != "18446744073709551615" {
+		t.Errorf("Value() of large uint64 = %v, %v", v, err)
+	}
+	if v, err := gocast.Some(uint64(5)).Value(); err != nil || v != int64(5) {
+		t.Errorf("Value() of uint64 = %v, %v", v, err)
+	}
+
+	var _ sql.Scanner = &o
+}
+
+func TestDecodeOptional(t *testing.T) {
+	type Patch struct {
+		Name  gocast.Optional[string] `cast:"name"`
+		Count gocast.Optional[int]    `cast:"count"`
+	}
+
+	var patch Patch
+	if err := gocast.Decode(map[string]any{"count": "0"}, &patch); err != nil {
+		t.Fatal(err)
+	}
+
+	if patch.Name.IsSet() || patch.Count.OrElse(-1) != 0 {
+		t.Errorf("Decode() = %+v", patch)
+	}
+}
@@ -0,0 +1,116 @@
+package gocast
+
+import (
+	"cmp"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// splitPath splits a dot separated path into its segments.
+// Bracket indexes are supported, e.g. "items[0].id" and "items.0.id" are equal.
+func splitPath(path string) []string {
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+	res := make([]string, 0)
+	for _, segment := range strings.Split(path, ".") {
+		if segment != "" {
+			res = append(res, segment)
+		}
+	}
+	return res
+}
+
+// comparePaths compares the paths by segments, numeric segments are compared as numbers
+// so that slice items are set in order, e.g. "a.2" before "a.10".
+func comparePaths(a, b string) int {
+	sa, sb := splitPath(a), splitPath(b)
+	for i := 0; i < len(sa) && i < len(sb); i++ {
+		x, errX := strconv.Atoi(sa[i])
+		y, errY := strconv.Atoi(sb[i])
+		if errX == nil && errY == nil && x != y {
+			return cmp.Compare(x, y)
+		} else if c := strings.Compare(sa[i], sb[i]); (errX != nil || errY != nil) && c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(sa), len(sb))
+}
+
+// lookupPath resolves path segments against nested maps, slices and arrays.
+// It returns false if any of the segments cannot be resolved.
+func lookupPath(data any, segments []string) (any, bool) {
+	current := data
+	for _, segment := range segments {
+		next, ok := lookupKey(current, segment)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+// lookupKey resolves a single key against a map, slice or array.
+func lookupKey(data any, key string) (any, bool) {
+	data = valueOf(data)
+	switch val := data.(type) {
+	case nil:
+		return nil, false
+	case map[string]any:
+		v, ok := val[key]
+		return v, ok
+	case []any:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(val) {
+			return nil, false
+		}
+		return val[i], true
+	}
+
+	rv := reflect.ValueOf(data)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		v := rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()))
+		if !v.IsValid() {
+			return nil, false
+		}
+		return v.Interface(), true
+	case reflect.Slice, reflect.Array:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= rv.Len() {
+			return nil, false
+		}
+		return rv.Index(i).Interface(), true
+	default:
+		return nil, false
+	}
+}
+
+// insertNested inserts the value into the nested map, creating intermediate maps as needed.
+// Empty segments are ignored. Existing maps are never replaced by the value,
+// while existing values are replaced by maps when a deeper path is inserted.
+func insertNested(m map[string]any, segments []string, value any) {
+	current := m
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		if i == len(segments)-1 {
+			if _, isMap := current[segment].(map[string]any); !isMap {
+				current[segment] = value
+			}
+			return
+		}
+
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[segment] = next
+		}
+		current = next
+	}
+}
@@ -0,0 +1,654 @@
+package gocast
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ValidateQuery checks the syntax of a query expression used by Caster.Query.
+func ValidateQuery(expr string) error {
+	_, err := parseQuery(expr)
+	return err
+}
+
+// queryValues evaluates the query expression against the data.
+// Invalid expressions yield no values.
+func queryValues(c *Converter, data any, expr string) iter.Seq[Caster] {
+	return func(yield func(Caster) bool) {
+		steps, err := parseQuery(expr)
+		if err != nil {
+			return
+		}
+
+		evalQuery(c, data, steps, func(v any) bool {
+			return yield(c.NewCaster(v))
+		})
+	}
+}
+
+// queryStep is a single segment of a query, e.g. ".name", "[0]" or "..price".
+type queryStep struct {
+	recursive bool
+	selector  querySelector
+}
+
+// querySelector selects child values of a node.
+// It stops and returns false when yield returns false.
+type querySelector interface {
+	selectFrom(c *Converter, node any, yield func(any) bool) bool
+}
+
+// evalQuery applies the query steps to the node and yields the results.
+func evalQuery(c *Converter, node any, steps []queryStep, yield func(any) bool) bool {
+	if len(steps) == 0 {
+		return yield(node)
+	}
+
+	step := steps[0]
+	next := func(v any) bool {
+		return evalQuery(c, v, steps[1:], yield)
+	}
+
+	if step.recursive {
+		return descend(node, func(n any) bool {
+			return step.selector.selectFrom(c, n, next)
+		})
+	}
+	return step.selector.selectFrom(c, node, next)
+}
+
+// descend calls fn for the node and all of its nested values in document order.
+func descend(node any, fn func(any) bool) bool {
+	if !fn(node) {
+		return false
+	}
+
+	for _, child := range childrenOf(node) {
+		if !descend(child, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// childrenOf returns the items of a slice or the values of a map ordered by key.
+// Other values have no children.
+func childrenOf(node any) []any {
+	node = valueOf(node)
+	switch val := node.(type) {
+	case nil, string, []byte:
+		return nil
+	case []any:
+		return val
+	case map[string]any:
+		res := make([]any, 0, len(val))
+		for _, key := range slices.Sorted(maps.Keys(val)) {
+			res = append(res, val[key])
+		}
+		return res
+	}
+
+	rv := reflect.ValueOf(node)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		res := make([]any, rv.Len())
+		for i := range res {
+			res[i] = rv.Index(i).Interface()
+		}
+		return res
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(a.String(), b.String())
+		})
+		res := make([]any, len(keys))
+		for i, key := range keys {
+			res[i] = rv.MapIndex(key).Interface()
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+// sequenceOf returns the items of a slice or an array.
+func sequenceOf(node any) ([]any, bool) {
+	node = valueOf(node)
+	if _, isMap := node.(map[string]any); isMap || node == nil {
+		return nil, false
+	}
+
+	kind := reflect.TypeOf(node).Kind()
+	if kind != reflect.Slice && kind != reflect.Array {
+		return nil, false
+	}
+	return childrenOf(node), true
+}
+
+// nameSelector selects map values by key.
+type nameSelector struct {
+	names []string
+}
+
+func (s nameSelector) selectFrom(_ *Converter, node any, yield func(any) bool) bool {
+	for _, name := range s.names {
+		if v, ok := lookupKey(node, name); ok {
+			if !yield(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// wildcardSelector selects all children.
+type wildcardSelector struct{}
+
+func (wildcardSelector) selectFrom(_ *Converter, node any, yield func(any) bool) bool {
+	for _, child := range childrenOf(node) {
+		if !yield(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// indexSelector selects slice items by index, negative indexes count from the end.
+type indexSelector struct {
+	indexes []int
+}
+
+func (s indexSelector) selectFrom(_ *Converter, node any, yield func(any) bool) bool {
+	items, ok := sequenceOf(node)
+	if !ok {
+		return true
+	}
+
+	for _, i := range s.indexes {
+		if i < 0 {
+			i += len(items)
+		}
+
+		if i >= 0 && i < len(items) && !yield(items[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sliceSelector selects slice items in the [start:end:step] range.
+type sliceSelector struct {
+	start, end *int
+	step       int
+}
+
+func (s sliceSelector) selectFrom(_ *Converter, node any, yield func(any) bool) bool {
+	items, ok := sequenceOf(node)
+	if !ok || s.step == 0 {
+		return true
+	}
+
+	bound := func(v *int, fallback int) int {
+		if v == nil {
+			return fallback
+		}
+
+		i := *v
+		if i < 0 {
+			i += len(items)
+		}
+		return max(-1, min(i, len(items)))
+	}
+
+	if s.step > 0 {
+		for i := max(bound(s.start, 0), 0); i < bound(s.end, len(items)); i += s.step {
+			if !yield(items[i]) {
+				return false
+			}
+		}
+	} else {
+		for i := min(bound(s.start, len(items)-1), len(items)-1); i > bound(s.end, -1); i += s.step {
+			if !yield(items[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// filterSelector selects the children matching the filter expression.
+type filterSelector struct {
+	// or holds the alternatives of the expression, each is a list of conditions joined by &&.
+	or [][]queryCondition
+}
+
+func (s filterSelector) selectFrom(c *Converter, node any, yield func(any) bool) bool {
+	for _, child := range childrenOf(node) {
+		if s.matches(c, child) && !yield(child) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s filterSelector) matches(c *Converter, node any) bool {
+	for _, and := range s.or {
+		matched := true
+		for _, cond := range and {
+			if !cond.matches(c, node) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// queryCondition is a comparison or an existence test of a filter expression.
+type queryCondition struct {
+	left, right queryOperand
+	op          string
+}
+
+func (q queryCondition) matches(c *Converter, node any) bool {
+	left, ok := q.left.resolve(node)
+	if q.op == "" {
+		return ok && valueOf(left) != nil
+	}
+
+	right, _ := q.right.resolve(node)
+	return compareValues(c, left, right, q.op)
+}
+
+// queryOperand is a literal value or a path relative to the current node (@).
+type queryOperand struct {
+	path    []string
+	literal any
+	isPath  bool
+}
+
+func (o queryOperand) resolve(node any) (any, bool) {
+	if !o.isPath {
+		return o.literal, true
+	}
+	return lookupPath(node, o.path)
+}
+
+// compareValues compares two values using the casting rules of the converter.
+// Bool and numeric operands convert the other operand to their type,
+// other values are compared as strings. Nil only equals nil.
+func compareValues(c *Converter, left, right any, op string) bool {
+	left, right = valueOf(left), valueOf(right)
+	if left == nil || right == nil {
+		switch op {
+		case "==":
+			return left == nil && right == nil
+		case "!=":
+			return left != nil || right != nil
+		default:
+			return false
+		}
+	}
+
+	_, leftBool := left.(bool)
+	_, rightBool := right.(bool)
+	if leftBool || rightBool {
+		l, errL := toBool(c, left)
+		r, errR := toBool(c, right)
+		if errL != nil || errR != nil {
+			return op == "!="
+		}
+		return compareOrdered(boolNumber(l), boolNumber(r), op)
+	}
+
+	if isNumeric(left) || isNumeric(right) {
+		l, errL := toFloat[float64](c, left)
+		r, errR := toFloat[float64](c, right)
+		if errL == nil && errR == nil {
+			return compareOrdered(l, r, op)
+		}
+	}
+
+	l, errL := toString(c, left)
+	r, errR := toString(c, right)
+	if errL != nil || errR != nil {
+		return op == "!="
+	}
+	return compareOrdered(l, r, op)
+}
+
+// compareOrdered compares two ordered values using the operator.
+func compareOrdered[T cmp.Ordered](a, b T, op string) bool {
+	switch op {
+	case "==":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	default:
+		return false
+	}
+}
+
+// boolNumber returns 1 for true and 0 for false.
+func boolNumber(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// isNumeric checks if the value is a number or a json.Number.
+func isNumeric(v any) bool {
+	if _, ok := v.(json.Number); ok {
+		return true
+	}
+
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseQuery parses a query expression into its steps.
+// The leading $ is optional, e.g. "$.items[*].price" and "items[*].price" are equal.
+func parseQuery(expr string) ([]queryStep, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "$") {
+		expr = expr[1:]
+	} else if expr != "" && expr[0] != '.' && expr[0] != '[' {
+		expr = "." + expr
+	}
+
+	steps := make([]queryStep, 0)
+	for pos := 0; pos < len(expr); {
+		step := queryStep{}
+		switch {
+		case strings.HasPrefix(expr[pos:], ".."):
+			step.recursive = true
+			pos += 2
+			if pos < len(expr) && expr[pos] == '[' {
+				break
+			}
+			fallthrough
+		case expr[pos] == '.':
+			if !step.recursive {
+				pos++
+			}
+
+			end := pos
+			for end < len(expr) && expr[end] != '.' && expr[end] != '[' {
+				end++
+			}
+
+			name := strings.TrimSpace(expr[pos:end])
+			if name == "" {
+				return nil, queryError(expr, pos)
+			} else if name == "*" {
+				step.selector = wildcardSelector{}
+			} else {
+				step.selector = nameSelector{names: []string{name}}
+			}
+			steps = append(steps, step)
+			pos = end
+			continue
+		case expr[pos] != '[':
+			return nil, queryError(expr, pos)
+		}
+
+		end := closingBracket(expr, pos)
+		if end < 0 {
+			return nil, queryError(expr, pos)
+		}
+
+		selector, err := parseBracket(strings.TrimSpace(expr[pos+1 : end]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+		}
+		step.selector = selector
+		steps = append(steps, step)
+		pos = end + 1
+	}
+	return steps, nil
+}
+
+// queryError returns a syntax error of the query at the position.
+func queryError(expr string, pos int) error {
+	return fmt.Errorf("invalid query %q at position %d", expr, pos)
+}
+
+// closingBracket returns the index of the bracket closing the bracket at pos.
+// Quoted strings and nested brackets or parentheses are skipped.
+func closingBracket(expr string, pos int) int {
+	depth := 0
+	var quote byte
+	for i := pos; i < len(expr); i++ {
+		switch ch := expr[i]; {
+		case quote != 0:
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == '[' || ch == '(':
+			depth++
+		case ch == ']' || ch == ')':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitOutside splits s by sep, ignoring separators inside quoted strings.
+func splitOutside(s, sep string) []string {
+	var res []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case quote != 0:
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case strings.HasPrefix(s[i:], sep):
+			res = append(res, s[start:i])
+			start = i + len(sep)
+			i += len(sep) - 1
+		}
+	}
+	return append(res, s[start:])
+}
+
+// parseBracket parses the content of a bracket step, e.g. "*", "0,1", "'a'", "1:3" or "?(@.x>1)".
+func parseBracket(content string) (querySelector, error) {
+	switch {
+	case content == "*":
+		return wildcardSelector{}, nil
+	case strings.HasPrefix(content, "?"):
+		expr := strings.TrimSpace(content[1:])
+		if !strings.HasPrefix(expr, "(") || !strings.HasSuffix(expr, ")") {
+			return nil, fmt.Errorf("filter %q must be enclosed in parentheses", content)
+		}
+		return parseFilter(expr[1 : len(expr)-1])
+	case len(splitOutside(content, ":")) > 1:
+		return parseSlice(content)
+	}
+
+	var names []string
+	var indexes []int
+	for _, item := range splitOutside(content, ",") {
+		item = strings.TrimSpace(item)
+		if s, ok := unquote(item); ok {
+			names = append(names, s)
+		} else if i, err := strconv.Atoi(item); err == nil {
+			indexes = append(indexes, i)
+		} else {
+			return nil, fmt.Errorf("invalid selector %q", item)
+		}
+	}
+
+	switch {
+	case len(names) > 0 && len(indexes) > 0:
+		return nil, fmt.Errorf("selector %q mixes names and indexes", content)
+	case len(names) > 0:
+		return nameSelector{names: names}, nil
+	default:
+		return indexSelector{indexes: indexes}, nil
+	}
+}
+
+// parseSlice parses a [start:end:step] range.
+func parseSlice(content string) (querySelector, error) {
+	parts := strings.Split(content, ":")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid slice %q", content)
+	}
+
+	res := sliceSelector{step: 1}
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slice %q", content)
+		}
+
+		switch i {
+		case 0:
+			res.start = &v
+		case 1:
+			res.end = &v
+		default:
+			res.step = v
+		}
+	}
+	return res, nil
+}
+
+// queryOperators lists the comparison operators, longer operators first.
+var queryOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+// parseFilter parses a filter expression of conditions joined by && and ||.
+func parseFilter(expr string) (querySelector, error) {
+	res := filterSelector{}
+	for _, alternative := range splitOutside(expr, "||") {
+		var and []queryCondition
+		for _, part := range splitOutside(alternative, "&&") {
+			cond, err := parseCondition(strings.TrimSpace(part))
+			if err != nil {
+				return nil, err
+			}
+			and = append(and, cond)
+		}
+		res.or = append(res.or, and)
+	}
+	return res, nil
+}
+
+// parseCondition parses a comparison (e.g. @.price < 10) or an existence test (e.g. @.price).
+func parseCondition(expr string) (queryCondition, error) {
+	for _, op := range queryOperators {
+		parts := splitOutside(expr, op)
+		if len(parts) == 1 {
+			continue
+		} else if len(parts) > 2 {
+			return queryCondition{}, fmt.Errorf("invalid condition %q", expr)
+		}
+
+		left, err := parseOperand(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return queryCondition{}, err
+		}
+
+		right, err := parseOperand(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return queryCondition{}, err
+		}
+		return queryCondition{left: left, right: right, op: op}, nil
+	}
+
+	operand, err := parseOperand(expr)
+	if err != nil {
+		return queryCondition{}, err
+	} else if !operand.isPath {
+		return queryCondition{}, fmt.Errorf("invalid condition %q", expr)
+	}
+	return queryCondition{left: operand}, nil
+}
+
+// parseOperand parses a relative path (e.g. @.user.name) or a literal value.
+func parseOperand(s string) (queryOperand, error) {
+	if strings.HasPrefix(s, "@") {
+		return queryOperand{path: splitPath(s[1:]), isPath: true}, nil
+	} else if v, ok := unquote(s); ok {
+		return queryOperand{literal: v}, nil
+	}
+
+	switch s {
+	case "true":
+		return queryOperand{literal: true}, nil
+	case "false":
+		return queryOperand{literal: false}, nil
+	case "null":
+		return queryOperand{literal: nil}, nil
+	}
+
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return queryOperand{literal: json.Number(s)}, nil
+	}
+	return queryOperand{}, fmt.Errorf("invalid operand %q", s)
+}
+
+// unquote returns the content of a single or double quoted string.
+func unquote(s string) (string, bool) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", false
+	}
+
+	inner := s[1 : len(s)-1]
+	var sb strings.Builder
+	for i := 0; i < len(inner); i++ {
+		if inner[i] == '\\' && i+1 < len(inner) {
+			i++
+		}
+		sb.WriteByte(inner[i])
+	}
+	return sb.String(), true
+}
@@ -0,0 +1,90 @@
+package gocast_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestQuery(t *testing.T) {
+	doc := gocast.NewCaster(map[string]any{
+		"store": map[string]any{
+			"name": "main",
+			"items": []any{
+				map[string]any{"id": 1, "price": 10.5, "active": true, "tag": "book"},
+				map[string]any{"id": 2, "price": "20", "active": "false", "tag": "pen"},
+				map[string]any{"id": 3, "price": 5, "active": 1, "tag": "book"},
+			},
+		},
+		"meta": map[string]any{"id": 99},
+	})
+
+	tests := []struct {
+		expr     string
+		expected []string
+	}{
+		{"$.store.items[*].price", []string{"10.5", "20", "5"}},
+		{"store.items[*].id", []string{"1", "2", "3"}},
+		{"$..id", []string{"99", "1", "2", "3"}},
+		{"$.store.items[0:2].id", []string{"1", "2"}},
+		{"$.store.items[-1:].id", []string{"3"}},
+		{"$.store.items[::-1].id", []string{"3", "2", "1"}},
+		{"$.store.items[0,2].id", []string{"1", "3"}},
+		{"$.store['name']", []string{"main"}},
+		{"$.store.items[?(@.active==true)].id", []string{"1", "3"}},
+		{"$.store.items[?(@.price > 8)].id", []string{"1", "2"}},
+		{"$.store.items[?(@.tag == 'book' && @.price < 8)].id", []string{"3"}},
+		{"$.store.items[?(@.id == 1 || @.id == '2')].tag", []string{"book", "pen"}},
+		{"$.store.items[?(@.missing)].id", nil},
+		{"$..items[?(@.tag != \"book\")].id", []string{"2"}},
+		{"$.store.missing[*]", nil},
+		{"$.store.items[", nil},
+	}
+
+	for _, test := range tests {
+		var result []string
+		for _, caster := range doc.Query(test.expr) {
+			result = append(result, caster.StringSafe("?"))
+		}
+
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Query(%s) = %v, expected %v", test.expr, result, test.expected)
+		}
+	}
+}
+
+func TestQueryIter(t *testing.T) {
+	doc, err := gocast.FromJSON([]byte(`{"a": [{"v": 1}, {"v": 2}, {"v": 3}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := 0
+	for caster := range doc.QueryIter("$.a[*].v") {
+		sum += caster.IntSafe(0)
+		if sum >= 3 {
+			break
+		}
+	}
+
+	if sum != 3 {
+		t.Errorf("QueryIter() sum = %v, expected 3", sum)
+	}
+}
+
+func TestValidateQuery(t *testing.T) {
+	valid := []string{"$", "$.a.b", "a[0]", "$..*", "$.a[1:3:2]", "$.a[?(@.x >= 1)]", "$['a','b']"}
+	for _, expr := range valid {
+		if err := gocast.ValidateQuery(expr); err != nil {
+			t.Errorf("ValidateQuery(%s) error = %v", expr, err)
+		}
+	}
+
+	invalid := []string{"$.", "$.a[", "$.a[x]", "$.a[?@.x]", "$.a[?(1)]", "$.a[0,'b']", "$.a[1:2:3:4]"}
+	for _, expr := range invalid {
+		if err := gocast.ValidateQuery(expr); err == nil {
+			t.Errorf("ValidateQuery(%s) expected error", expr)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package gocast
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ToRat casts an interface to an exact rational number.
+// Floats are converted using their shortest decimal representation (e.g. 0.1 to 1/10)
+// and strings are parsed as decimals, or as percentages, fractions and ratios
+// enabled by WithParseModes.
+func ToRat(value any) (*big.Rat, error) {
+	return toRat(defaultConverter, value)
+}
+
+// ToRat casts an interface to an exact rational number using the converter options.
+func (c *Converter) ToRat(value any) (*big.Rat, error) {
+	return toRat(c, value)
+}
+
+// toRat casts an interface to a rational number using the converter options.
+func toRat(c *Converter, value any) (*big.Rat, error) {
+	value = valueOf(value)
+	switch val := value.(type) {
+	case nil:
+		return nil, c.nilError("*big.Rat")
+	case bool:
+		if val {
+			return big.NewRat(1, 1), nil
+		}
+		return new(big.Rat), nil
+	case int, int8, int16, int32, int64:
+		n, err := toSigned[int64](c, val)
+		return new(big.Rat).SetInt64(n), err
+	case uint, uint8, uint16, uint32, uint64:
+		n, err := toUnsigned[uint64](c, val)
+		return new(big.Rat).SetUint64(n), err
+	case float32:
+		return ratFromFloat(float64(val), 32)
+	case float64:
+		return ratFromFloat(val, 64)
+	case big.Rat:
+		return new(big.Rat).Set(&val), nil
+	case big.Int:
+		return new(big.Rat).SetInt(&val), nil
+	case big.Float:
+		if val.IsInf() {
+			return nil, typeError("*big.Rat")
+		}
+		r, _ := val.Rat(nil)
+		return r, nil
+	case string:
+		return parseRat(c, val)
+	case []byte:
+		return parseRat(c, string(val))
+	case json.Number:
+		return parseRat(c, string(val))
+	case driver.Valuer:
+		v, err := val.Value()
+		if err != nil {
+			return nil, err
+		}
+		return toRat(c, v)
+	default:
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return nil, err
+			}
+			return parseRat(c, text)
+		}
+		return nil, typeError("*big.Rat")
+	}
+}
+
+// ratFromFloat converts a float to a rational number using its shortest decimal representation.
+func ratFromFloat(f float64, bitSize int) (*big.Rat, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, typeError("*big.Rat")
+	}
+
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, bitSize))
+	return r, nil
+}
+
+// parseRat parses a rational number string using the converter options.
+func parseRat(c *Converter, s string) (*big.Rat, error) {
+	if c.isEmpty(s) {
+		return nil, c.emptyError("*big.Rat")
+	}
+
+	s = c.normalizeNumber(s)
+	if r, ok := c.parseModed(s); ok {
+		return r, nil
+	} else if r, ok := c.parseMultiplied(s); ok {
+		return r, nil
+	} else if r, ok := parseDecimal(strings.TrimSpace(s)); ok {
+		return r, nil
+	}
+	return nil, typeError("*big.Rat")
+}
+
+// formatFloat formats a float as decimal string or percentage.
+func formatFloat(c *Converter, f float64, bitSize int) string {
+	if c.options.percentFormat {
+		if r, err := ratFromFloat(f, bitSize); err == nil {
+			return formatPercent(r, c.options.percentDigits)
+		}
+	}
+	return strconv.FormatFloat(f, 'f', -1, bitSize)
+}
+
+// formatRat formats a rational number as fraction (e.g. "3/4") or percentage.
+func formatRat(c *Converter, r *big.Rat) string {
+	if c.options.percentFormat {
+		return formatPercent(r, c.options.percentDigits)
+	}
+	return r.RatString()
+}
+
+// formatPercent formats the rational number as percentage with at most precision decimals.
+func formatPercent(r *big.Rat, precision int) string {
+	s := new(big.Rat).Mul(r, big.NewRat(100, 1)).FloatString(max(precision, 0))
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s + "%"
+}
@@ -0,0 +1,103 @@
+package gocast_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestParseModes(t *testing.T) {
+	conv := gocast.NewConverter(gocast.WithParseModes(gocast.ParsePercent | gocast.ParseFraction | gocast.ParseRatio))
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"15%", 0.15},
+		{" 2.5 % ", 0.025},
+		{"3/4", 0.75},
+		{"-1/8", -0.125},
+		{"1:4", 0.25},
+		{"1.5", 1.5},
+	}
+
+	for _, test := range tests {
+		if v, err := conv.ToFloat64(test.input); err != nil || v != test.expected {
+			t.Errorf("ToFloat64(%q) = %v, %v, expected %v", test.input, v, err, test.expected)
+		}
+	}
+
+	for _, input := range []string{"1/0", "a/b", "%", "1:2:3"} {
+		if _, err := conv.ToFloat64(input); !gocast.IsCastError(err) {
+			t.Errorf("ToFloat64(%q) error = %v, expected cast error", input, err)
+		}
+	}
+
+	raw := gocast.NewConverter(gocast.WithParseModes(gocast.ParsePercentRaw))
+	if v, err := raw.ToFloat64("15%"); err != nil || v != 15 {
+		t.Errorf("ToFloat64(15%%) raw = %v, %v", v, err)
+	}
+	if _, err := raw.ToFloat64("3/4"); !gocast.IsCastError(err) {
+		t.Errorf("ToFloat64(3/4) without fraction mode error = %v, expected cast error", err)
+	}
+
+	if _, err := gocast.ToFloat[float64]("15%"); !gocast.IsCastError(err) {
+		t.Errorf("ToFloat(15%%) without modes error = %v, expected cast error", err)
+	}
+}
+
+func TestToRat(t *testing.T) {
+	conv := gocast.NewConverter(gocast.WithParseModes(gocast.ParsePercent | gocast.ParseFraction))
+	tests := []struct {
+		input    any
+		expected *big.Rat
+	}{
+		{"1/3", big.NewRat(1, 3)},
+		{"12.5%", big.NewRat(1, 8)},
+		{"0.1", big.NewRat(1, 10)},
+		{0.1, big.NewRat(1, 10)},
+		{float32(0.25), big.NewRat(1, 4)},
+		{7, big.NewRat(7, 1)},
+		{uint8(3), big.NewRat(3, 1)},
+		{big.NewInt(5), big.NewRat(5, 1)},
+		{big.NewRat(2, 3), big.NewRat(2, 3)},
+	}
+
+	for _, test := range tests {
+		if v, err := conv.ToRat(test.input); err != nil || v.Cmp(test.expected) != 0 {
+			t.Errorf("ToRat(%v) = %v, %v, expected %v", test.input, v, err, test.expected)
+		}
+	}
+
+	if _, err := gocast.ToRat("abc"); !gocast.IsCastError(err) {
+		t.Errorf("ToRat(abc) error = %v, expected cast error", err)
+	}
+	if _, err := gocast.ToRat(nil); !gocast.IsNilError(err) {
+		t.Errorf("ToRat(nil) error = %v, expected nil error", err)
+	}
+}
+
+func TestPercentFormat(t *testing.T) {
+	conv := gocast.NewConverter(gocast.WithPercentFormat(1))
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{0.155, "15.5%"},
+		{0.15, "15%"},
+		{float32(0.5), "50%"},
+		{-0.25, "-25%"},
+		{big.NewRat(1, 3), "33.3%"},
+		{10, "10"},
+	}
+
+	for _, test := range tests {
+		if v, err := conv.ToString(test.input); err != nil || v != test.expected {
+			t.Errorf("ToString(%v) = %q, %v, expected %q", test.input, v, err, test.expected)
+		}
+	}
+
+	if v, err := gocast.ToString(big.NewRat(3, 4)); err != nil || v != "3/4" {
+		t.Errorf("ToString(3/4) = %q, %v", v, err)
+	}
+}
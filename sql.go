@@ -0,0 +1,67 @@
+package gocast
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// ScanValue is a database/sql compatible value holder.
+// It implements the sql.Scanner and driver.Valuer interfaces and exposes
+// the Caster API for the scanned value. It is useful for scanning dynamic
+// columns and converting them later.
+type ScanValue struct {
+	casterDriver
+}
+
+// Scan implements the sql.Scanner interface.
+// Byte slices are copied, because the driver may reuse the underlying buffer.
+func (s *ScanValue) Scan(src any) error {
+	if b, ok := src.([]byte); ok {
+		src = append([]byte(nil), b...)
+	}
+	s.data = src
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (s ScanValue) Value() (driver.Value, error) {
+	if s.IsNil() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(s.data)
+}
+
+// ScanRows scans all remaining rows into a slice of column name to Caster maps.
+// Rows are closed after scanning.
+func ScanRows(rows *sql.Rows) ([]map[string]Caster, error) {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]map[string]Caster, 0)
+	for rows.Next() {
+		values := make([]ScanValue, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]Caster, len(columns))
+		for i, column := range columns {
+			row[column] = values[i]
+		}
+		res = append(res, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,146 @@
+package gocast_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestSQLNullTypes(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected int64
+		nilErr   bool
+	}{
+		{sql.NullInt64{Int64: 12, Valid: true}, 12, false},
+		{sql.NullInt64{Int64: 12, Valid: false}, 0, true},
+		{sql.NullString{String: "42", Valid: true}, 42, false},
+		{sql.NullFloat64{Float64: 3.5, Valid: true}, 3, false},
+		{sql.Null[int]{V: 7, Valid: true}, 7, false},
+		{sql.Null[int]{V: 7, Valid: false}, 0, true},
+		{[]byte("123"), 123, false},
+	}
+
+	for _, test := range tests {
+		result, err := gocast.ToSigned[int64](test.input)
+		if test.nilErr {
+			if !gocast.IsNilError(err) {
+				t.Errorf("ToSigned(%v) error = %v, expected nil error", test.input, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("ToSigned(%v) error = %v", test.input, err)
+		}
+
+		if result != test.expected {
+			t.Errorf("ToSigned(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+
+	if v, err := gocast.ToString(sql.NullString{String: "hello", Valid: true}); err != nil || v != "hello" {
+		t.Errorf("ToString(NullString) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToBool(sql.NullBool{Bool: true, Valid: true}); err != nil || !v {
+		t.Errorf("ToBool(NullBool) = %v, %v", v, err)
+	}
+
+	if v, err := gocast.ToFloat[float64]([]byte("1.25")); err != nil || v != 1.25 {
+		t.Errorf("ToFloat([]byte) = %v, %v", v, err)
+	}
+}
+
+func TestScanValue(t *testing.T) {
+	var v gocast.ScanValue
+	if !v.IsNil() {
+		t.Error("zero ScanValue must be nil")
+	}
+
+	buf := []byte("99")
+	if err := v.Scan(buf); err != nil {
+		t.Fatal(err)
+	}
+	buf[0] = '1'
+
+	if i := v.IntSafe(0); i != 99 {
+		t.Errorf("ScanValue.Int() = %v, expected 99", i)
+	}
+
+	if res, err := v.Value(); err != nil || string(res.([]byte)) != "99" {
+		t.Errorf("ScanValue.Value() = %v, %v", res, err)
+	}
+}
+
+func TestScanRows(t *testing.T) {
+	sql.Register("gocast_fake", fakeDriver{})
+	db, err := sql.Open("gocast_fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := gocast.ScanRows(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 2 {
+		t.Fatalf("ScanRows() returns %d rows, expected 2", len(res))
+	}
+
+	if id := res[1]["id"].IntSafe(0); id != 2 {
+		t.Errorf("row[1].id = %v, expected 2", id)
+	}
+
+	if !res[1]["name"].IsNil() {
+		t.Errorf("row[1].name must be nil")
+	}
+}
+
+// fakeDriver is a minimal driver that returns fixed rows for any query.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return -1 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{data: [][]driver.Value{
+		{[]byte("1"), []byte("john")},
+		{[]byte("2"), nil},
+	}}, nil
+}
+
+type fakeRows struct {
+	data [][]driver.Value
+}
+
+func (*fakeRows) Columns() []string { return []string{"id", "name"} }
+func (*fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.data) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.data[0])
+	r.data = r.data[1:]
+	return nil
+}
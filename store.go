@@ -0,0 +1,472 @@
+package gocast
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Source provides configuration values for a Store.
+type Source interface {
+	// Load returns the nested values of the source.
+	Load() (map[string]any, error)
+}
+
+// SourceFunc is a function implementing the Source interface.
+type SourceFunc func() (map[string]any, error)
+
+// Load calls the function.
+func (fn SourceFunc) Load() (map[string]any, error) {
+	return fn()
+}
+
+// watchedSource is implemented by sources that detect changes, such as files.
+type watchedSource interface {
+	changed() bool
+}
+
+// MapSource returns a source of the nested map. Dotted keys (e.g. "db.host") are nested.
+func MapSource(m map[string]any) Source {
+	return SourceFunc(func() (map[string]any, error) {
+		res := make(map[string]any)
+		for _, key := range slices.SortedFunc(maps.Keys(m), comparePaths) {
+			if err := NewDocument(res).Set(key, cloneValue(m[key])); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	})
+}
+
+// EnvSource returns a source of the environment variables starting with the prefix.
+// Keys are nested as in Environment.Prefix, e.g. APP_DB_HOST with APP_ prefix is "db.host".
+// A nil env uses the os environment.
+func EnvSource(env Environment, prefix string) Source {
+	if env == nil {
+		env = defaultEnv
+	}
+
+	return SourceFunc(func() (map[string]any, error) {
+		res, _ := env.Prefix(prefix).Interface().(map[string]any)
+		return res, nil
+	})
+}
+
+// FlagSource returns a source of the flags set on the command line.
+// Flag names are nested by dots, e.g. -http.port=8080 is "http.port".
+func FlagSource(fs *flag.FlagSet) Source {
+	return SourceFunc(func() (map[string]any, error) {
+		doc := NewDocument(nil)
+		var err error
+		fs.Visit(func(f *flag.Flag) {
+			var value any = f.Value.String()
+			if getter, ok := f.Value.(flag.Getter); ok {
+				value = getter.Get()
+			}
+
+			if e := doc.Set(f.Name, value); e != nil && err == nil {
+				err = e
+			}
+		})
+		return doc.Map(), err
+	})
+}
+
+// JSONFileSource returns a source of the JSON file parsed by FromJSON.
+// The file is polled for changes by Store.Poll.
+func JSONFileSource(path string) Source {
+	return &fileSource{path: path, parse: parseWith(FromJSONReader)}
+}
+
+// DotenvFileSource returns a source of the dotenv file parsed by ParseDotenv.
+// The file is polled for changes by Store.Poll.
+func DotenvFileSource(path string) Source {
+	return &fileSource{path: path, parse: parseWith(ParseDotenv)}
+}
+
+// INIFileSource returns a source of the INI file parsed by ParseINI.
+// The file is polled for changes by Store.Poll.
+func INIFileSource(path string) Source {
+	return &fileSource{path: path, parse: parseWith(ParseINI)}
+}
+
+// parseWith returns a file parser using the document parser.
+func parseWith(parse func(r io.Reader, opts ...Option) (Caster, error)) func(data []byte) (map[string]any, error) {
+	return func(data []byte) (map[string]any, error) {
+		doc, err := parse(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+
+		res, ok := doc.Interface().(map[string]any)
+		if !ok {
+			return nil, typeError("map[string]any")
+		}
+		return res, nil
+	}
+}
+
+// OptionalSource returns a source that loads no values if the source file does not exist.
+func OptionalSource(src Source) Source {
+	return optionalSource{src}
+}
+
+type optionalSource struct {
+	Source
+}
+
+func (s optionalSource) Load() (map[string]any, error) {
+	res, err := s.Source.Load()
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]any{}, nil
+	}
+	return res, err
+}
+
+func (s optionalSource) changed() bool {
+	watched, ok := s.Source.(watchedSource)
+	return ok && watched.changed()
+}
+
+// fileSource loads a file and tracks its modification time and size.
+type fileSource struct {
+	path  string
+	parse func(data []byte) (map[string]any, error)
+
+	mutex   sync.Mutex
+	modTime time.Time
+	size    int64
+	exists  bool
+}
+
+func (s *fileSource) Load() (map[string]any, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, err := os.Stat(s.path)
+	s.exists = err == nil
+	if err != nil {
+		return nil, err
+	}
+	s.modTime, s.size = info.ModTime(), info.Size()
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	return s.parse(data)
+}
+
+func (s *fileSource) changed() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return s.exists
+	}
+	return !s.exists || !info.ModTime().Equal(s.modTime) || info.Size() != s.size
+}
+
+// Store is a layered configuration combining defaults and ordered sources.
+// Later sources take precedence over earlier ones and maps are merged recursively.
+// Store is safe for concurrent use.
+type Store struct {
+	conv     *Converter
+	sources  []Source
+	required []string
+
+	// reloadMutex serializes loading of sources.
+	reloadMutex sync.Mutex
+
+	mutex     sync.RWMutex
+	defaults  *Document
+	layers    []map[string]any
+	data      *Document
+	listeners []func(changed []string)
+	onError   []func(err error)
+}
+
+// NewStore creates a new Store of the sources in order of precedence.
+// Values are not available until Load is called.
+func NewStore(sources ...Source) *Store {
+	return defaultConverter.NewStore(sources...)
+}
+
+// NewStore creates a new Store of the sources that converts using the converter options.
+func (c *Converter) NewStore(sources ...Source) *Store {
+	return &Store{
+		conv:     c,
+		sources:  sources,
+		defaults: c.NewDocument(nil),
+		data:     c.NewDocument(nil),
+	}
+}
+
+// SetDefault sets the default value of the path, used when no source provides the path.
+func (s *Store) SetDefault(path string, value any) error {
+	s.mutex.Lock()
+	if err := s.defaults.Set(path, value); err != nil {
+		s.mutex.Unlock()
+		return err
+	}
+	changed := s.apply(s.layers, s.merge(s.layers))
+	listeners := s.listeners
+	s.mutex.Unlock()
+
+	notify(listeners, changed)
+	return nil
+}
+
+// Require adds paths that must be provided by the defaults or sources.
+// Missing paths fail Load and Reload with FieldErrors.
+func (s *Store) Require(paths ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.required = append(s.required, paths...)
+}
+
+// OnChange registers a callback called with the sorted changed paths after each
+// reload that changes values. Paths are flattened, e.g. "db.hosts.0".
+func (s *Store) OnChange(fn func(changed []string)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.listeners = append(s.listeners, fn)
+}
+
+// OnError registers a callback called with the reload errors of Watch.
+func (s *Store) OnError(fn func(err error)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.onError = append(s.onError, fn)
+}
+
+// Load loads the sources, it is an alias of Reload.
+func (s *Store) Load() error {
+	return s.Reload()
+}
+
+// Reload loads all sources and replaces the values of the store.
+// On error (including missing required paths) the previous values are kept.
+func (s *Store) Reload() error {
+	s.reloadMutex.Lock()
+	defer s.reloadMutex.Unlock()
+
+	layers := make([]map[string]any, 0, len(s.sources))
+	for _, src := range s.sources {
+		m, err := src.Load()
+		if err != nil {
+			return err
+		}
+		layers = append(layers, m)
+	}
+
+	s.mutex.Lock()
+	data := s.merge(layers)
+	if err := s.validate(data); err != nil {
+		s.mutex.Unlock()
+		return err
+	}
+	changed := s.apply(layers, data)
+	listeners := s.listeners
+	s.mutex.Unlock()
+
+	notify(listeners, changed)
+	return nil
+}
+
+// Poll reloads the store if any file source changed since the last load.
+// It reports whether the store was reloaded.
+func (s *Store) Poll() (bool, error) {
+	for _, src := range s.sources {
+		if watched, ok := src.(watchedSource); ok && watched.changed() {
+			return true, s.Reload()
+		}
+	}
+	return false, nil
+}
+
+// Watch polls the file sources at the interval until the context is done.
+// Reload errors are passed to the OnError callbacks and previous values are kept.
+func (s *Store) Watch(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := s.Poll(); err != nil {
+				s.mutex.RLock()
+				callbacks := s.onError
+				s.mutex.RUnlock()
+
+				for _, fn := range callbacks {
+					fn(err)
+				}
+			}
+		}
+	}
+}
+
+// merge merges the defaults and the layers into a new document.
+func (s *Store) merge(layers []map[string]any) *Document {
+	res := s.defaults.Clone()
+	for _, layer := range layers {
+		_ = res.Merge(layer, MergeReplace)
+	}
+	return res
+}
+
+// validate checks the required paths of the document.
+func (s *Store) validate(doc *Document) error {
+	var errs FieldErrors
+	for _, path := range s.required {
+		if doc.Get(path).IsNil() {
+			errs = append(errs, &FieldError{Path: path, Err: requiredErr()})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// apply replaces the layers and the values with the merged data of the layers and
+// returns the changed paths. It must be called with the mutex locked.
+func (s *Store) apply(layers []map[string]any, data *Document) []string {
+	changed := changedPaths(s.data.Map(), data.Map())
+	s.layers = layers
+	s.data = data
+	return changed
+}
+
+// notify calls the listeners with the changed paths.
+func notify(listeners []func(changed []string), changed []string) {
+	if len(changed) == 0 {
+		return
+	}
+
+	for _, fn := range listeners {
+		fn(slices.Clone(changed))
+	}
+}
+
+// changedPaths returns the sorted flattened paths with different values.
+func changedPaths(old, current map[string]any) []string {
+	before, _ := Flatten(old, FlattenOptions{})
+	after, _ := Flatten(current, FlattenOptions{})
+
+	// Empty documents are flattened to an empty key
+	delete(before, "")
+	delete(after, "")
+
+	var res []string
+	for key, value := range after {
+		if prev, ok := before[key]; !ok || !reflect.DeepEqual(prev, value) {
+			res = append(res, key)
+		}
+	}
+
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			res = append(res, key)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// Get returns a Caster for the value at the path.
+func (s *Store) Get(path string) Caster {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.data.Get(path)
+}
+
+// Has checks if the path has a non-nil value.
+func (s *Store) Has(path string) bool {
+	return !s.Get(path).IsNil()
+}
+
+// Keys returns the sorted flattened paths of all values.
+func (s *Store) Keys() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	flat, _ := Flatten(s.data.Map(), FlattenOptions{})
+	delete(flat, "")
+	return slices.Sorted(maps.Keys(flat))
+}
+
+// Decode decodes the values at the path into the value pointed by out, an empty path decodes all values.
+func (s *Store) Decode(path string, out any) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var input any = s.data.Map()
+	if path != "" {
+		input = s.data.Get(path).Interface()
+	}
+	return s.conv.Decode(input, out)
+}
+
+// Bool returns the bool value of the path or the fallback.
+func (s *Store) Bool(path string, fallback bool) bool {
+	return s.Get(path).BoolSafe(fallback)
+}
+
+// Int returns the int value of the path or the fallback.
+func (s *Store) Int(path string, fallback int) int {
+	return s.Get(path).IntSafe(fallback)
+}
+
+// Int64 returns the int64 value of the path or the fallback.
+func (s *Store) Int64(path string, fallback int64) int64 {
+	return s.Get(path).Int64Safe(fallback)
+}
+
+// Uint returns the uint value of the path or the fallback.
+func (s *Store) Uint(path string, fallback uint) uint {
+	return s.Get(path).UintSafe(fallback)
+}
+
+// Float64 returns the float64 value of the path or the fallback.
+func (s *Store) Float64(path string, fallback float64) float64 {
+	return s.Get(path).Float64Safe(fallback)
+}
+
+// String returns the string value of the path or the fallback.
+func (s *Store) String(path string, fallback string) string {
+	return s.Get(path).StringSafe(fallback)
+}
+
+// Duration returns the time.Duration value of the path or the fallback.
+func (s *Store) Duration(path string, fallback time.Duration) time.Duration {
+	v, err := s.conv.ToDuration(s.Get(path).Interface())
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// StringSlice returns the []string value of the path or the fallback.
+func (s *Store) StringSlice(path string, fallback []string) []string {
+	return s.Get(path).StringSliceSafe(fallback)
+}
+
+// IntSlice returns the []int value of the path or the fallback.
+func (s *Store) IntSlice(path string, fallback []int) []int {
+	return s.Get(path).IntSliceSafe(fallback)
+}
@@ -0,0 +1,161 @@
+package gocast_test
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestStorePrecedence(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "config.json")
+	envPath := filepath.Join(dir, ".env")
+	writeFile(t, jsonPath, `{"http": {"port": 8080, "host": "localhost"}, "db": {"hosts": ["a", "b"]}}`)
+	writeFile(t, envPath, "# comment\nexport HTTP_HOST=\"0.0.0.0\"\nDB_NAME=app\nDB_URL=${DB_NAME}.db\n")
+
+	env := gocast.NewEnvironment(nil, func() []string { return []string{"APP_HTTP_PORT=9090", "OTHER=1"} })
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Bool("debug", false, "")
+	fs.String("db.name", "", "")
+	fs.Int("unused", 5, "")
+	if err := fs.Parse([]string{"-debug", "-db.name=prod"}); err != nil {
+		t.Fatal(err)
+	}
+
+	store := gocast.NewStore(
+		gocast.MapSource(map[string]any{"log.level": "info", "log.tags.1": "b", "log.tags.0": "a"}),
+		gocast.JSONFileSource(jsonPath),
+		gocast.DotenvFileSource(envPath),
+		gocast.OptionalSource(gocast.JSONFileSource(filepath.Join(dir, "missing.json"))),
+		gocast.EnvSource(env, "APP_"),
+		gocast.FlagSource(fs),
+	)
+	if err := store.SetDefault("http.timeout", "5s"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := store.Int("http.port", 0); v != 9090 {
+		t.Errorf("http.port = %v, expected 9090", v)
+	}
+	if v := store.String("http.host", ""); v != "0.0.0.0" {
+		t.Errorf("http.host = %v, expected 0.0.0.0", v)
+	}
+	if v := store.String("db.name", ""); v != "prod" {
+		t.Errorf("db.name = %v, expected prod", v)
+	}
+	if v := store.String("db.url", ""); v != "app.db" {
+		t.Errorf("db.url = %v, expected app.db", v)
+	}
+	if v := store.StringSlice("db.hosts", nil); !reflect.DeepEqual(v, []string{"a", "b"}) {
+		t.Errorf("db.hosts = %v", v)
+	}
+	if v := store.String("log.level", ""); v != "info" {
+		t.Errorf("log.level = %v, expected info", v)
+	}
+	if v := store.StringSlice("log.tags", nil); !reflect.DeepEqual(v, []string{"a", "b"}) {
+		t.Errorf("log.tags = %v", v)
+	}
+	if !store.Bool("debug", false) || store.Has("unused") {
+		t.Errorf("flags not loaded as expected: %v", store.Keys())
+	}
+	if v := store.Duration("http.timeout", 0); v != 5*time.Second {
+		t.Errorf("http.timeout = %v, expected 5s", v)
+	}
+	if v := store.Int("missing", 42); v != 42 {
+		t.Errorf("missing = %v, expected fallback", v)
+	}
+
+	var cfg struct {
+		Port int    `json:"port"`
+		Host string `json:"host"`
+	}
+	if err := store.Decode("http", &cfg); err != nil || cfg.Port != 9090 || cfg.Host != "0.0.0.0" {
+		t.Errorf("Decode(http) = %+v, %v", cfg, err)
+	}
+}
+
+func TestStoreRequired(t *testing.T) {
+	store := gocast.NewStore(gocast.MapSource(map[string]any{"a": 1}))
+	store.Require("a", "b.c")
+	err := store.Load()
+
+	var errs gocast.FieldErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != "b.c" || !gocast.IsRequiredError(errs[0].Err) {
+		t.Fatalf("Load() error = %v, expected required b.c", err)
+	}
+
+	if store.Has("a") {
+		t.Errorf("values applied despite failed validation")
+	}
+
+	if err := store.SetDefault("b.c", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Load(); err != nil || store.Int("a", 0) != 1 {
+		t.Errorf("Load() with default = %v", err)
+	}
+
+	notified := false
+	store.OnChange(func([]string) { notified = true })
+	if err := store.SetDefault("b.c.d", 1); err == nil || notified {
+		t.Errorf("SetDefault() through a bool error = %v, notified = %v", err, notified)
+	}
+}
+
+func TestStoreReload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	writeFile(t, path, `{"a": 1, "b": {"c": "x"}}`)
+
+	store := gocast.NewStore(gocast.JSONFileSource(path))
+	var changes [][]string
+	store.OnChange(func(changed []string) { changes = append(changes, changed) })
+
+	if err := store.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if reloaded, err := store.Poll(); reloaded || err != nil {
+		t.Errorf("Poll() of unchanged file = %v, %v", reloaded, err)
+	}
+
+	writeFile(t, path, `{"a": 2, "b": {"d": "y"}}`)
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	if reloaded, err := store.Poll(); !reloaded || err != nil {
+		t.Fatalf("Poll() of changed file = %v, %v", reloaded, err)
+	}
+
+	expected := [][]string{{"a", "b.c"}, {"a", "b.c", "b.d"}}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("changes = %v, expected %v", changes, expected)
+	}
+
+	writeFile(t, path, `{invalid`)
+	if err := store.Reload(); err == nil {
+		t.Errorf("Reload() of invalid file expected error")
+	}
+	if v := store.Int("a", 0); v != 2 {
+		t.Errorf("a after failed reload = %v, expected 2", v)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
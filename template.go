@@ -0,0 +1,57 @@
+package gocast
+
+import (
+	"text/template"
+)
+
+// FuncMap returns the casting functions for text/template and html/template.
+//
+// Available functions are toBool, toInt, toInt64, toUint, toUint64, toFloat,
+// toString, toSlice, toBoolSlice, toIntSlice, toInt64Slice, toUintSlice,
+// toFloatSlice, toStringSlice and default.
+// Casting functions return the zero value for nil input (e.g. missing map keys)
+// and abort template execution on invalid values.
+// The default function returns the fallback for empty values:
+//
+//	{{ .Page | toInt }}
+//	{{ .Title | default "Untitled" }}
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"toBool":        templateFunc(ToBool),
+		"toInt":         templateFunc(ToSigned[int]),
+		"toInt64":       templateFunc(ToSigned[int64]),
+		"toUint":        templateFunc(ToUnsigned[uint]),
+		"toUint64":      templateFunc(ToUnsigned[uint64]),
+		"toFloat":       templateFunc(ToFloat[float64]),
+		"toString":      templateFunc(ToString),
+		"toSlice":       templateFunc(ToSlice),
+		"toBoolSlice":   templateFunc(ToBoolSlice),
+		"toIntSlice":    templateFunc(ToSignedSlice[int]),
+		"toInt64Slice":  templateFunc(ToSignedSlice[int64]),
+		"toUintSlice":   templateFunc(ToUnsignedSlice[uint]),
+		"toFloatSlice":  templateFunc(ToFloatSlice[float64]),
+		"toStringSlice": templateFunc(ToStringSlice),
+		"default":       templateDefault,
+	}
+}
+
+// templateFunc wraps a converter to return the zero value instead of nil errors.
+func templateFunc[T any](fn func(any) (T, error)) func(any) (T, error) {
+	return func(value any) (T, error) {
+		res, err := fn(value)
+		if IsNilError(err) {
+			var zero T
+			return zero, nil
+		}
+		return res, err
+	}
+}
+
+// templateDefault returns the fallback if the value is missing or empty.
+// The value is optional to support piping nil values, e.g. {{ .Missing | default "x" }}.
+func templateDefault(fallback any, value ...any) any {
+	if len(value) == 0 || isEmptyValue(value[0]) {
+		return fallback
+	}
+	return value[0]
+}
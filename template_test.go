@@ -0,0 +1,66 @@
+package gocast_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestFuncMap(t *testing.T) {
+	tests := []struct {
+		tmpl     string
+		data     any
+		expected string
+		err      bool
+	}{
+		{`{{ add (toInt .A) 1 }}`, map[string]any{"A": "41"}, "42", false},
+		{`{{ toFloat .A }}`, map[string]any{"A": "1.5"}, "1.5", false},
+		{`{{ toBool .A }}`, map[string]any{"A": "true"}, "true", false},
+		{`{{ toInt .Missing }}`, map[string]any{}, "0", false},
+		{`{{ .Missing | default "none" }}`, map[string]any{}, "none", false},
+		{`{{ .A | default "none" }}`, map[string]any{"A": ""}, "none", false},
+		{`{{ .A | default "none" }}`, map[string]any{"A": "set"}, "set", false},
+		{`{{ range toIntSlice .A }}{{ . }};{{ end }}`, map[string]any{"A": []string{"1", "2"}}, "1;2;", false},
+		{`{{ toInt .A }}`, map[string]any{"A": "invalid"}, "", true},
+	}
+
+	for _, test := range tests {
+		funcs := gocast.FuncMap()
+		funcs["add"] = func(a, b int) int { return a + b }
+
+		var sb strings.Builder
+		err := template.Must(template.New("").Funcs(funcs).Parse(test.tmpl)).Execute(&sb, test.data)
+		if (err != nil) != test.err {
+			t.Errorf("%s error = %v, expected error = %v", test.tmpl, err, test.err)
+			continue
+		}
+
+		if !test.err && sb.String() != test.expected {
+			t.Errorf("%s = %v, expected %v", test.tmpl, sb.String(), test.expected)
+		}
+	}
+
+	// html/template compatibility
+	htmltemplate.Must(htmltemplate.New("").Funcs(gocast.FuncMap()).Parse(`{{ toString . }}`))
+}
+
+func TestToStringTemplateTypes(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{htmltemplate.HTML("<b>"), "<b>"},
+		{htmltemplate.JS("alert(1)"), "alert(1)"},
+		{htmltemplate.URL("https://example.com"), "https://example.com"},
+		{htmltemplate.CSS("a{}"), "a{}"},
+	}
+
+	for _, test := range tests {
+		if result, err := gocast.ToString(test.input); err != nil || result != test.expected {
+			t.Errorf("ToString(%v) = %v, %v", test.input, result, err)
+		}
+	}
+}
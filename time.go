@@ -0,0 +1,154 @@
+package gocast
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"reflect"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// defaultTimeLayouts are the time layouts used when no layout is configured.
+var defaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.DateTime,
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// ToTime casts an interface to a time.Time type.
+// Strings are parsed using RFC3339, DateTime, DateOnly and RFC1123 layouts
+// and numbers are treated as unix timestamps in seconds.
+func ToTime(value interface{}) (time.Time, error) {
+	return toTime(defaultConverter, value)
+}
+
+// ToDuration casts an interface to a time.Duration type.
+// Strings are parsed using time.ParseDuration (e.g. "1m30s")
+// and numbers are treated as nanoseconds.
+func ToDuration(value interface{}) (time.Duration, error) {
+	return toDuration(defaultConverter, value)
+}
+
+// ToTime casts an interface to a time.Time type using the converter time layouts.
+func (c *Converter) ToTime(value any) (time.Time, error) {
+	return toTime(c, value)
+}
+
+// ToDuration casts an interface to a time.Duration type.
+func (c *Converter) ToDuration(value any) (time.Duration, error) {
+	return toDuration(c, value)
+}
+
+// toTime casts an interface to a time.Time type using the converter options.
+func toTime(c *Converter, value any) (time.Time, error) {
+	value = valueOf(value)
+	switch val := value.(type) {
+	case nil:
+		return time.Time{}, c.nilError("time.Time")
+	case time.Time:
+		return val, nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		sec, err := toSigned[int64](c, val)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(sec, 0), nil
+	case float32, float64:
+		f, err := toFloat[float64](c, val)
+		if err != nil {
+			return time.Time{}, err
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)), nil
+	case string:
+		return parseTime(c, val)
+	case []byte:
+		return parseTime(c, string(val))
+	case json.Number:
+		return parseTime(c, string(val))
+	case driver.Valuer:
+		v, err := val.Value()
+		if err != nil {
+			return time.Time{}, err
+		}
+		return toTime(c, v)
+	default:
+		if text, ok, err := textOf(val); ok {
+			if err != nil {
+				return time.Time{}, err
+			}
+			return parseTime(c, text)
+		}
+		return time.Time{}, typeError("time.Time")
+	}
+}
+
+// parseTime parses a time string using the converter time layouts.
+// Numeric strings are treated as unix timestamps in seconds.
+func parseTime(c *Converter, s string) (time.Time, error) {
+	if c.isEmpty(s) {
+		return time.Time{}, c.emptyError("time.Time")
+	}
+
+	layouts := c.options.timeLayouts
+	if layouts == nil {
+		layouts = defaultTimeLayouts
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	if f, err := parseFloat[float64](c, s); err == nil {
+		return toTime(c, f)
+	}
+	return time.Time{}, typeError("time.Time")
+}
+
+// toDuration casts an interface to a time.Duration type using the converter options.
+func toDuration(c *Converter, value any) (time.Duration, error) {
+	value = valueOf(value)
+	switch val := value.(type) {
+	case nil:
+		return 0, c.nilError("time.Duration")
+	case time.Duration:
+		return val, nil
+	case string:
+		return parseDuration(c, val)
+	case []byte:
+		return parseDuration(c, string(val))
+	default:
+		v, err := toSigned[int64](c, val)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(v), nil
+	}
+}
+
+// parseDuration parses a duration string, numeric strings are treated as nanoseconds.
+func parseDuration(c *Converter, s string) (time.Duration, error) {
+	if c.isEmpty(s) {
+		return 0, c.emptyError("time.Duration")
+	}
+
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	v, err := parseSigned[int64](c, s)
+	if err != nil {
+		return 0, typeError("time.Duration")
+	}
+	return time.Duration(v), nil
+}
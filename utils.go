@@ -3,11 +3,15 @@
 package gocast
 
 import (
+	"encoding"
 	"fmt"
 	"math"
 	"reflect"
 )
 
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
 // valueOf returns the value that the input interface{} points to,
 // traversing through any number of pointer indirections. If the input
 // is not a pointer or is nil, it returns the input itself. This function
@@ -59,15 +63,46 @@ func stringOf(value any) any {
 	return val.Interface()
 }
 
-// isImplementsOf checks if a type T implements an interface I.
-//
-// This function is a generic utility that determines whether a type T
-// implements an interface I at compile time. It can be useful for
-// compile-time assertions and type checking.
-func isImplementsOf[T any, I any]() bool {
-	var o T
-	_, ok := interface{}(o).(I)
-	return ok
+// textOf returns the text representation of a value that implements
+// the encoding.TextMarshaler interface. Since valueOf dereferences pointers,
+// types implementing the interface on a pointer receiver are also supported.
+// The second return value reports whether the value is a text marshaler.
+func textOf(value any) (string, bool, error) {
+	if value == nil {
+		return "", false, nil
+	}
+
+	marshaler, ok := value.(encoding.TextMarshaler)
+	if !ok {
+		typ := reflect.TypeOf(value)
+		if !reflect.PointerTo(typ).Implements(textMarshalerType) {
+			return "", false, nil
+		}
+
+		ptr := reflect.New(typ)
+		ptr.Elem().Set(reflect.ValueOf(value))
+		marshaler = ptr.Interface().(encoding.TextMarshaler)
+	}
+
+	text, err := marshaler.MarshalText()
+	return string(text), true, err
+}
+
+// isEmptyValue checks if the value is nil, a nil pointer or the zero value of its type.
+// Empty slices and maps are also considered empty.
+func isEmptyValue(value any) bool {
+	value = valueOf(value)
+	if value == nil {
+		return true
+	}
+
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return val.Len() == 0
+	default:
+		return val.IsZero()
+	}
 }
 
 // typeName returns the name of the type T as a string.
@@ -106,23 +141,65 @@ func intInRange[T int | int8 | int16 | int32 | int64](value int64) bool {
 //
 // This function is useful for determining whether a value can be safely
 // converted to a different unsigned integer type without overflow.
-func uintInRange[T uint | uint8 | uint16 | uint32 | uint64](original int64, value uint64) bool {
-	if original > 0 {
-		var sample T
-		switch any(sample).(type) {
-		case uint:
-			return value <= math.MaxUint
-		case uint8:
-			return value <= math.MaxUint8
-		case uint16:
-			return value <= math.MaxUint16
-		case uint32:
-			return value <= math.MaxUint32
-		case uint64:
-			return value <= math.MaxUint64
-		}
+func uintInRange[T uint | uint8 | uint16 | uint32 | uint64](value uint64) bool {
+	var sample T
+	switch any(sample).(type) {
+	case uint:
+		return value <= math.MaxUint
+	case uint8:
+		return value <= math.MaxUint8
+	case uint16:
+		return value <= math.MaxUint16
+	case uint32:
+		return value <= math.MaxUint32
+	case uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// signedBounds returns the minimum and maximum values of the signed integer type T.
+func signedBounds[T int | int8 | int16 | int32 | int64]() (int64, int64) {
+	var sample T
+	switch any(sample).(type) {
+	case int:
+		return math.MinInt, math.MaxInt
+	case int8:
+		return math.MinInt8, math.MaxInt8
+	case int16:
+		return math.MinInt16, math.MaxInt16
+	case int32:
+		return math.MinInt32, math.MaxInt32
+	default:
+		return math.MinInt64, math.MaxInt64
+	}
+}
+
+// unsignedMax returns the maximum value of the unsigned integer type T.
+func unsignedMax[T uint | uint8 | uint16 | uint32 | uint64]() uint64 {
+	var sample T
+	switch any(sample).(type) {
+	case uint:
+		return math.MaxUint
+	case uint8:
+		return math.MaxUint8
+	case uint16:
+		return math.MaxUint16
+	case uint32:
+		return math.MaxUint32
+	default:
+		return math.MaxUint64
+	}
+}
+
+// floatMax returns the maximum finite value of the float type T.
+func floatMax[T float32 | float64]() float64 {
+	var sample T
+	if _, ok := any(sample).(float32); ok {
+		return math.MaxFloat32
 	}
-	return false
+	return math.MaxFloat64
 }
 
 // floatInRange checks if a given float64 value falls within the range of a specified floating-point type T.
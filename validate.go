@@ -0,0 +1,352 @@
+package gocast
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidationError describes a value violating a validation rule.
+type ValidationError struct {
+	// Path is the dot separated path of the field, e.g. "server.port".
+	Path string
+	// Rule is the violated rule, e.g. "min=1".
+	Rule string
+	// Value is the converted value, nil for missing required values.
+	Value any
+}
+
+func (e *ValidationError) Error() string {
+	if e.Rule == "required" {
+		return errorRequired
+	}
+	return fmt.Sprintf("value %v violates rule %s", e.Value, e.Rule)
+}
+
+// Unwrap returns the required error for the required rule.
+func (e *ValidationError) Unwrap() error {
+	if e.Rule == "required" {
+		return requiredErr()
+	}
+	return nil
+}
+
+// IsValidationError checks if the provided error is a validation error.
+// It returns true if the error is not nil and contains a *ValidationError.
+func IsValidationError(err error) bool {
+	var ve *ValidationError
+	return errors.As(err, &ve)
+}
+
+// validationRule is a validation rule of a struct tag, e.g. "min=1".
+type validationRule struct {
+	name string
+	arg  string
+}
+
+func (r validationRule) String() string {
+	if r.arg == "" {
+		return r.name
+	}
+	return r.name + "=" + r.arg
+}
+
+// parseRules returns the validation rules of the tag options, other options are ignored.
+// The regex rule consumes the remaining options, so patterns may contain commas.
+func parseRules(options []string) []validationRule {
+	var res []validationRule
+	for i, option := range options {
+		name, arg, _ := strings.Cut(strings.TrimSpace(option), "=")
+		switch name {
+		case "required", "min", "max", "len", "oneof":
+			res = append(res, validationRule{name: name, arg: arg})
+		case "regex":
+			_, arg, _ = strings.Cut(strings.Join(options[i:], ","), "=")
+			return append(res, validationRule{name: name, arg: arg})
+		}
+	}
+	return res
+}
+
+// hasRule checks if the rules contains the named rule.
+func hasRule(rules []validationRule, name string) bool {
+	return slices.ContainsFunc(rules, func(r validationRule) bool { return r.name == name })
+}
+
+// validateValue checks the rules against the value and returns the error of the first violated rule.
+func validateValue(rules []validationRule, v reflect.Value, path string) error {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			if hasRule(rules, "required") {
+				return fieldError(path, &ValidationError{Path: path, Rule: "required"})
+			}
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	for _, rule := range rules {
+		ok, err := checkRule(rule, v)
+		if err != nil {
+			return fieldError(path, err)
+		} else if !ok {
+			return fieldError(path, &ValidationError{Path: path, Rule: rule.String(), Value: v.Interface()})
+		}
+	}
+	return nil
+}
+
+// checkRule checks if the value satisfies the rule.
+func checkRule(rule validationRule, v reflect.Value) (bool, error) {
+	switch rule.name {
+	case "required":
+		return !isEmptyField(v), nil
+	case "min", "max":
+		res, err := compareRule(v, rule.arg)
+		if err != nil {
+			return false, fmt.Errorf("invalid rule %s for %s: %w", rule, v.Type(), err)
+		}
+		return (rule.name == "min" && res >= 0) || (rule.name == "max" && res <= 0), nil
+	case "len":
+		n, ok := lengthOf(v)
+		low, high, err := parseRange(rule.arg)
+		if !ok || err != nil {
+			return false, fmt.Errorf("invalid rule %s for %s", rule, v.Type())
+		}
+		return n >= low && n <= high, nil
+	case "oneof":
+		s, err := ToString(v.Interface())
+		if err != nil {
+			return false, err
+		}
+		return slices.Contains(strings.Split(rule.arg, "|"), s), nil
+	case "regex":
+		re, err := compileRule(rule.arg)
+		if err != nil {
+			return false, fmt.Errorf("invalid rule %s: %w", rule, err)
+		}
+
+		s, err := ToString(v.Interface())
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(s), nil
+	default:
+		return true, nil
+	}
+}
+
+// compareRule compares the number (or the length) of the value with the rule argument.
+func compareRule(v reflect.Value, arg string) (int, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Type() == durationType {
+			d, err := ToDuration(arg)
+			return cmp.Compare(v.Int(), int64(d)), err
+		}
+		n, err := ToSigned[int64](arg)
+		return cmp.Compare(v.Int(), n), err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := ToUnsigned[uint64](arg)
+		return cmp.Compare(v.Uint(), n), err
+	case reflect.Float32, reflect.Float64:
+		n, err := ToFloat[float64](arg)
+		return cmp.Compare(v.Float(), n), err
+	}
+
+	if v.Type() == timeType {
+		t, err := ToTime(arg)
+		return v.Interface().(time.Time).Compare(t), err
+	}
+
+	length, ok := lengthOf(v)
+	if !ok {
+		return 0, typeError("number")
+	}
+
+	n, err := ToSigned[int](arg)
+	return cmp.Compare(length, n), err
+}
+
+// lengthOf returns the length of strings (in runes), slices, arrays and maps.
+func lengthOf(v reflect.Value) (int, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(v.String()), true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// parseRange parses a "min..max" or exact length range.
+func parseRange(arg string) (int, int, error) {
+	low, high, isRange := strings.Cut(arg, "..")
+	if !isRange {
+		high = low
+	}
+
+	l, err := strconv.Atoi(strings.TrimSpace(low))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	h, err := strconv.Atoi(strings.TrimSpace(high))
+	return l, h, err
+}
+
+// ruleExpressions caches the compiled regex rules.
+var ruleExpressions sync.Map
+
+// compileRule returns the cached compiled regex of the pattern.
+func compileRule(pattern string) (*regexp.Regexp, error) {
+	if re, ok := ruleExpressions.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	ruleExpressions.Store(pattern, re)
+	return re, nil
+}
+
+// Validator validates the converted value of a Caster using fluent rules.
+type Validator struct {
+	conv *Converter
+	data any
+	path string
+}
+
+// Int converts the value to int for validation.
+func (v *Validator) Int() *NumberRule[int] {
+	n, err := toSigned[int](v.conv, v.data)
+	return &NumberRule[int]{path: v.path, value: n, err: err}
+}
+
+// Int64 converts the value to int64 for validation.
+func (v *Validator) Int64() *NumberRule[int64] {
+	n, err := toSigned[int64](v.conv, v.data)
+	return &NumberRule[int64]{path: v.path, value: n, err: err}
+}
+
+// Uint converts the value to uint for validation.
+func (v *Validator) Uint() *NumberRule[uint] {
+	n, err := toUnsigned[uint](v.conv, v.data)
+	return &NumberRule[uint]{path: v.path, value: n, err: err}
+}
+
+// Float64 converts the value to float64 for validation.
+func (v *Validator) Float64() *NumberRule[float64] {
+	n, err := toFloat[float64](v.conv, v.data)
+	return &NumberRule[float64]{path: v.path, value: n, err: err}
+}
+
+// String converts the value to string for validation.
+func (v *Validator) String() *StringRule {
+	s, err := toString(v.conv, v.data)
+	return &StringRule{path: v.path, value: s, err: err}
+}
+
+// NumberRule validates a converted number. Rules are skipped after the first error.
+type NumberRule[T numberType] struct {
+	path  string
+	value T
+	err   error
+}
+
+// Min checks if the value is greater than or equal to min.
+func (r *NumberRule[T]) Min(limit T) *NumberRule[T] {
+	return r.check(r.value >= limit, fmt.Sprintf("min=%v", limit))
+}
+
+// Max checks if the value is less than or equal to max.
+func (r *NumberRule[T]) Max(limit T) *NumberRule[T] {
+	return r.check(r.value <= limit, fmt.Sprintf("max=%v", limit))
+}
+
+// OneOf checks if the value is one of the values.
+func (r *NumberRule[T]) OneOf(values ...T) *NumberRule[T] {
+	names := make([]string, len(values))
+	for i, value := range values {
+		names[i] = fmt.Sprint(value)
+	}
+	return r.check(slices.Contains(values, r.value), "oneof="+strings.Join(names, "|"))
+}
+
+// Value returns the value or the first conversion or validation error.
+func (r *NumberRule[T]) Value() (T, error) {
+	return r.value, r.err
+}
+
+// check sets the validation error of the rule if ok is false.
+func (r *NumberRule[T]) check(ok bool, rule string) *NumberRule[T] {
+	if r.err == nil && !ok {
+		r.err = fieldError(r.path, &ValidationError{Path: r.path, Rule: rule, Value: r.value})
+	}
+	return r
+}
+
+// StringRule validates a converted string. Rules are skipped after the first error.
+type StringRule struct {
+	path  string
+	value string
+	err   error
+}
+
+// Required checks if the value is not empty.
+func (r *StringRule) Required() *StringRule {
+	return r.check(r.value != "", "required")
+}
+
+// Len checks if the length of the value in runes is between low and high (inclusive).
+func (r *StringRule) Len(low, high int) *StringRule {
+	n := utf8.RuneCountInString(r.value)
+	return r.check(n >= low && n <= high, fmt.Sprintf("len=%d..%d", low, high))
+}
+
+// OneOf checks if the value is one of the values.
+func (r *StringRule) OneOf(values ...string) *StringRule {
+	return r.check(slices.Contains(values, r.value), "oneof="+strings.Join(values, "|"))
+}
+
+// Regex checks if the value matches the regular expression pattern.
+func (r *StringRule) Regex(pattern string) *StringRule {
+	if r.err != nil {
+		return r
+	}
+
+	re, err := compileRule(pattern)
+	if err != nil {
+		r.err = err
+		return r
+	}
+	return r.check(re.MatchString(r.value), "regex="+pattern)
+}
+
+// Value returns the value or the first conversion or validation error.
+func (r *StringRule) Value() (string, error) {
+	return r.value, r.err
+}
+
+// check sets the validation error of the rule if ok is false.
+func (r *StringRule) check(ok bool, rule string) *StringRule {
+	if r.err == nil && !ok {
+		err := &ValidationError{Path: r.path, Rule: rule, Value: r.value}
+		if rule == "required" {
+			err.Value = nil
+		}
+		r.err = fieldError(r.path, err)
+	}
+	return r
+}
@@ -0,0 +1,118 @@
+package gocast_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gocast"
+)
+
+func TestDecodeValidation(t *testing.T) {
+	type Server struct {
+		Host    string        `cast:"host,required,regex=^[a-z.]{1,5}$"`
+		Port    int           `cast:"port,min=1,max=65535"`
+		Mode    string        `cast:"mode,oneof=dev|prod"`
+		Tags    []string      `cast:"tags,len=1..2"`
+		Timeout time.Duration `cast:"timeout,max=1m"`
+	}
+
+	valid := map[string]any{"host": "a.b", "port": "8080", "mode": "prod", "tags": []string{"x"}, "timeout": "30s"}
+	var server Server
+	if err := gocast.Decode(valid, &server); err != nil || server.Port != 8080 {
+		t.Fatalf("Decode() = %+v, %v", server, err)
+	}
+
+	tests := []struct {
+		key   string
+		value any
+		rule  string
+	}{
+		{"port", 0, "min=1"},
+		{"port", "70000", "max=65535"},
+		{"mode", "test", "oneof=dev|prod"},
+		{"tags", []string{}, "len=1..2"},
+		{"host", "UPPER", "regex=^[a-z.]{1,5}$"},
+		{"timeout", "2m", "max=1m"},
+		{"host", nil, "required"},
+	}
+
+	for _, test := range tests {
+		input := make(map[string]any)
+		for key, value := range valid {
+			input[key] = value
+		}
+		input[test.key] = test.value
+
+		err := gocast.Decode(input, &Server{})
+		var ve *gocast.ValidationError
+		if !errors.As(err, &ve) || ve.Rule != test.rule || ve.Path != test.key {
+			t.Errorf("Decode(%s=%v) error = %v, expected rule %s", test.key, test.value, err, test.rule)
+		}
+	}
+
+	err := gocast.Decode(map[string]any{"port": 1}, &Server{})
+	if !gocast.IsRequiredError(err) || !gocast.IsValidationError(err) {
+		t.Errorf("Decode() of missing required field error = %v", err)
+	}
+
+	type Invalid struct {
+		Count int `cast:"count,min=abc"`
+	}
+	if err := gocast.Decode(map[string]any{"count": 1}, &Invalid{}); err == nil || gocast.IsValidationError(err) {
+		t.Errorf("Decode() with invalid rule error = %v", err)
+	}
+}
+
+func TestBindValidation(t *testing.T) {
+	type Request struct {
+		Page  int    `query:"page,min=1"`
+		Sort  string `query:"sort,oneof=asc|desc"`
+		Token string `header:"X-Token,required"`
+	}
+
+	var req Request
+	err := gocast.Bind(httptest.NewRequest("GET", "/?page=0&sort=asc", nil), &req)
+
+	var errs gocast.FieldErrors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("Bind() error = %v, expected 2 field errors", err)
+	}
+
+	if errs[0].Path != "page" || !gocast.IsValidationError(errs[0]) || errs[1].Path != "X-Token" || !gocast.IsRequiredError(errs[1]) {
+		t.Errorf("Bind() errors = %v", errs)
+	}
+}
+
+func TestCasterValidate(t *testing.T) {
+	if v, err := gocast.NewCaster("8080").Validate("port").Int().Min(1).Max(65535).Value(); err != nil || v != 8080 {
+		t.Errorf("Validate().Int() = %v, %v", v, err)
+	}
+
+	_, err := gocast.NewCaster(0).Validate("port").Int().Min(1).Max(10).Value()
+	var ve *gocast.ValidationError
+	if !errors.As(err, &ve) || ve.Path != "port" || ve.Rule != "min=1" || ve.Value != 0 {
+		t.Errorf("Validate().Int().Min() error = %v", err)
+	}
+
+	if _, err := gocast.NewCaster("abc").Validate("").Int().Min(1).Value(); !gocast.IsCastError(err) {
+		t.Errorf("Validate().Int() conversion error = %v", err)
+	}
+
+	if _, err := gocast.NewCaster(2.5).Validate("ratio").Float64().OneOf(1, 2).Value(); !gocast.IsValidationError(err) {
+		t.Errorf("Validate().Float64().OneOf() error = %v", err)
+	}
+
+	if v, err := gocast.NewCaster("prod").Validate("mode").String().Required().Len(2, 5).OneOf("dev", "prod").Regex("^[a-z]+$").Value(); err != nil || v != "prod" {
+		t.Errorf("Validate().String() = %v, %v", v, err)
+	}
+
+	if _, err := gocast.NewCaster("").Validate("name").String().Required().Value(); !gocast.IsRequiredError(err) {
+		t.Errorf("Validate().String().Required() error = %v", err)
+	}
+
+	if _, err := gocast.NewCaster("x").Validate("name").String().Regex("[").Value(); err == nil || gocast.IsValidationError(err) {
+		t.Errorf("Validate().String().Regex() with invalid pattern error = %v", err)
+	}
+}